@@ -0,0 +1,139 @@
+package cur
+
+// CheckedCursor is a Cursor that also exposes a bounds-checked variant of its
+// navigation methods, returning the item alongside a boolean that reports whether
+// the operation landed within the bounds of the slice.
+//
+// This allows telling apart a zero-valued item in the slice from the zero-value
+// returned as EOF
+type CheckedCursor[T any] interface {
+	Cursor[T]
+
+	// CurOK returns the item in the current position, and whether the cursor
+	// is within the bounds of the slice
+	CurOK() (T, bool)
+
+	// NextOK advances the cursor, returning the next item in the slice and whether
+	// it was within the bounds of the slice
+	NextOK() (T, bool)
+
+	// PrevOK rewinds the cursor, returning the previous item in the slice and whether
+	// it was within the bounds of the slice
+	PrevOK() (T, bool)
+
+	// PeekOK returns the next indexed item without advancing the cursor, and whether
+	// it was within the bounds of the slice
+	PeekOK() (T, bool)
+
+	// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+	// whether `idx` was within the bounds of the slice
+	//
+	// The cursor is not moved if `idx` is out of bounds
+	IdxOK(idx int) (T, bool)
+
+	// OffsetOK advances or rewinds `amount` steps in the slice, returning the item and
+	// whether the result offset was within the bounds of the slice
+	//
+	// The cursor is not moved if the result offset is out of bounds
+	OffsetOK(amount int) (T, bool)
+
+	// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+	// `idx` was within the bounds of the slice
+	PeekIdxOK(idx int) (T, bool)
+
+	// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+	// and whether the result offset was within the bounds of the slice
+	PeekOffsetOK(amount int) (T, bool)
+}
+
+// Check returns a CheckedCursor for the input Cursor `c`, or nil if `c` is nil
+//
+// If `c` already implements CheckedCursor it is returned as-is; otherwise it is wrapped
+// in a CheckedCursor that derives the bounds checks from the cursor's Pos and Len
+func Check[T any](c Cursor[T]) CheckedCursor[T] {
+	if c == nil {
+		return nil
+	}
+	if cc, ok := c.(CheckedCursor[T]); ok {
+		return cc
+	}
+	return checked[T]{c}
+}
+
+type checked[T any] struct {
+	Cursor[T]
+}
+
+// pos returns the cursor's position, as Len when it is placed at the end of the slice
+func (c checked[T]) pos() int {
+	if p := c.Pos(); p >= 0 {
+		return p
+	}
+	return c.Len()
+}
+
+func (c checked[T]) in(idx int) bool {
+	return idx >= 0 && idx < c.Len()
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the slice
+func (c checked[T]) CurOK() (T, bool) {
+	return c.Cur(), c.in(c.pos())
+}
+
+// NextOK advances the cursor, returning the next item in the slice and whether
+// it was within the bounds of the slice
+func (c checked[T]) NextOK() (T, bool) {
+	ok := c.in(c.pos())
+	return c.Next(), ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the slice and whether
+// it was within the bounds of the slice
+func (c checked[T]) PrevOK() (T, bool) {
+	ok := c.in(c.pos() - 1)
+	return c.Prev(), ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the slice
+func (c checked[T]) PeekOK() (T, bool) {
+	return c.Peek(), c.in(c.pos() + 1)
+}
+
+// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+// whether `idx` was within the bounds of the slice
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c checked[T]) IdxOK(idx int) (T, bool) {
+	if !c.in(idx) {
+		var eof T
+		return eof, false
+	}
+	return c.Idx(idx), true
+}
+
+// OffsetOK advances or rewinds `amount` steps in the slice, returning the item and
+// whether the result offset was within the bounds of the slice
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c checked[T]) OffsetOK(amount int) (T, bool) {
+	return c.IdxOK(c.pos() + amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the slice
+func (c checked[T]) PeekIdxOK(idx int) (T, bool) {
+	if !c.in(idx) {
+		var eof T
+		return eof, false
+	}
+	return c.PeekIdx(idx), true
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the slice
+func (c checked[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.PeekIdxOK(c.pos() + amount)
+}
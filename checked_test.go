@@ -0,0 +1,109 @@
+package cur
+
+import "testing"
+
+// foreign hides the checked methods of the wrapped cursor, to test the adapter
+type foreign[T any] struct {
+	Cursor[T]
+}
+
+func TestChecked(t *testing.T) {
+	zeroes := []int{0, 1, 0}
+
+	for _, test := range []struct {
+		name string
+		c    func() CheckedCursor[int]
+	}{
+		{"New", func() CheckedCursor[int] { return New(zeroes).(CheckedCursor[int]) }},
+		{"Ptr", func() CheckedCursor[int] { return Ptr(&zeroes).(CheckedCursor[int]) }},
+		{"Adapter", func() CheckedCursor[int] { return Check[int](foreign[int]{New(zeroes)}) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Next", func(t *testing.T) {
+				c := test.c()
+				for i := range zeroes {
+					v, ok := c.NextOK()
+					if !ok {
+						t.Errorf("expected item %d to be within bounds", i)
+					}
+					if v != zeroes[i] {
+						t.Errorf("unexpected value: wanted %d ; got %d", zeroes[i], v)
+					}
+				}
+				if _, ok := c.NextOK(); ok {
+					t.Errorf("expected EOF")
+				}
+				if _, ok := c.CurOK(); ok {
+					t.Errorf("expected EOF")
+				}
+			})
+			t.Run("Prev", func(t *testing.T) {
+				c := test.c()
+				if _, ok := c.PrevOK(); ok {
+					t.Errorf("expected EOF")
+				}
+				c.Idx(2)
+				v, ok := c.PrevOK()
+				if !ok || v != zeroes[1] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[1], v, ok)
+				}
+			})
+			t.Run("Peek", func(t *testing.T) {
+				c := test.c()
+				v, ok := c.PeekOK()
+				if !ok || v != zeroes[1] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[1], v, ok)
+				}
+				v, ok = c.PeekIdxOK(2)
+				if !ok || v != zeroes[2] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[2], v, ok)
+				}
+				if _, ok = c.PeekIdxOK(3); ok {
+					t.Errorf("expected EOF")
+				}
+				v, ok = c.PeekOffsetOK(2)
+				if !ok || v != zeroes[2] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[2], v, ok)
+				}
+				if _, ok = c.PeekOffsetOK(-1); ok {
+					t.Errorf("expected EOF")
+				}
+				if c.Pos() != 0 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+				}
+			})
+			t.Run("Idx", func(t *testing.T) {
+				c := test.c()
+				v, ok := c.IdxOK(2)
+				if !ok || v != zeroes[2] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[2], v, ok)
+				}
+				if _, ok = c.IdxOK(3); ok {
+					t.Errorf("expected EOF")
+				}
+				if c.Pos() != 2 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+				}
+			})
+			t.Run("Offset", func(t *testing.T) {
+				c := test.c()
+				v, ok := c.OffsetOK(1)
+				if !ok || v != zeroes[1] {
+					t.Errorf("unexpected value: wanted %d ; got %d (%v)", zeroes[1], v, ok)
+				}
+				if _, ok = c.OffsetOK(-2); ok {
+					t.Errorf("expected EOF")
+				}
+				if c.Pos() != 1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+				}
+			})
+		})
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		if Check[int](nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+}
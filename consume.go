@@ -0,0 +1,83 @@
+package cur
+
+import "slices"
+
+// TakeWhile consumes the items in the Cursor `c` while they match `pred`, starting from
+// its current position, returning them as in Extract
+//
+// On cursors that wrap around, like Ring, it stops after a full lap, only returning the
+// items consumed before the end of the slice
+func TakeWhile[T any](c Cursor[T], pred func(T) bool) []T {
+	start := int(c.Mark())
+	n := SkipWhile(c, pred)
+	return c.Extract(start, start+n)
+}
+
+// SkipWhile consumes the items in the Cursor `c` while they match `pred`, starting from
+// its current position, returning the number of consumed items
+//
+// On cursors that wrap around, like Ring, it stops after a full lap
+func SkipWhile[T any](c Cursor[T], pred func(T) bool) int {
+	cc := Check(c)
+	steps := lap(c)
+
+	var n int
+	for n != steps {
+		v, ok := cc.CurOK()
+		if !ok || !pred(v) {
+			return n
+		}
+		cc.NextOK()
+		n++
+	}
+	return n
+}
+
+// Until consumes the items in the Cursor `c` until one matches `pred` or the end of
+// the slice is reached, starting from its current position, returning them as in
+// Extract. The matching item is not consumed
+//
+// On cursors that wrap around, like Ring, it stops after a full lap, only returning the
+// items consumed before the end of the slice
+func Until[T any](c Cursor[T], pred func(T) bool) []T {
+	return TakeWhile(c, func(v T) bool {
+		return !pred(v)
+	})
+}
+
+// Expect consumes the item in the current position of the Cursor `c` if it is equal to
+// `v`, returning an *UnexpectedError otherwise, or a *BoundsError if the cursor is
+// placed at the end of the slice
+func Expect[T comparable](c Cursor[T], v T) error {
+	cc := Check(c)
+	got, ok := cc.CurOK()
+	if !ok {
+		return &BoundsError{Op: "Expect", Index: int(c.Mark()), Len: c.Len()}
+	}
+	if got != v {
+		return &UnexpectedError{Index: int(c.Mark()), Want: v, Got: got}
+	}
+	cc.NextOK()
+	return nil
+}
+
+// Accept consumes the item in the current position of the Cursor `c` if it is one of
+// `vs`, returning whether it was consumed
+func Accept[T comparable](c Cursor[T], vs ...T) bool {
+	cc := Check(c)
+	if v, ok := cc.CurOK(); !ok || !slices.Contains(vs, v) {
+		return false
+	}
+	cc.NextOK()
+	return true
+}
+
+// AcceptRun consumes the items in the Cursor `c` while they are one of `vs`, starting
+// from its current position, returning the number of consumed items
+//
+// On cursors that wrap around, like Ring, it stops after a full lap
+func AcceptRun[T comparable](c Cursor[T], vs ...T) int {
+	return SkipWhile(c, func(v T) bool {
+		return slices.Contains(vs, v)
+	})
+}
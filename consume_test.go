@@ -0,0 +1,125 @@
+package cur
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+)
+
+func TestConsume(t *testing.T) {
+	src := []rune("let  x1 = 42;")
+
+	t.Run("TakeWhile", func(t *testing.T) {
+		c := New(src)
+		if v := string(TakeWhile(c, unicode.IsLetter)); v != "let" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "let", v)
+		}
+		if v := c.Cur(); v != ' ' {
+			t.Errorf("unexpected value: wanted %q ; got %q", ' ', v)
+		}
+		if v := TakeWhile(c, unicode.IsLetter); len(v) != 0 {
+			t.Errorf("unexpected value: wanted %q ; got %q", "", string(v))
+		}
+	})
+	t.Run("SkipWhile", func(t *testing.T) {
+		c := New(src)
+		c.Idx(3)
+		if n := SkipWhile(c, unicode.IsSpace); n != 2 {
+			t.Errorf("unexpected count: wanted %d ; got %d", 2, n)
+		}
+		if c.Pos() != 5 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 5, c.Pos())
+		}
+	})
+	t.Run("Until", func(t *testing.T) {
+		c := New(src)
+		if v := string(Until(c, func(r rune) bool { return r == '=' })); v != "let  x1 " {
+			t.Errorf("unexpected value: wanted %q ; got %q", "let  x1 ", v)
+		}
+		if v := string(Until(c, func(r rune) bool { return r == '!' })); v != "= 42;" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "= 42;", v)
+		}
+		if c.Pos() != -1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+		}
+	})
+	t.Run("Expect", func(t *testing.T) {
+		c := New(src)
+		if err := Expect(c, 'l'); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		var target *UnexpectedError
+		err := Expect(c, 'x')
+		if !errors.Is(err, ErrUnexpected) || !errors.As(err, &target) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if target.Index != 1 || target.Want != 'x' || target.Got != 'e' {
+			t.Errorf("unexpected error: %+v", target)
+		}
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+
+		c.Tail()
+		c.Next()
+		if err := Expect(c, ';'); !errors.Is(err, ErrOutOfBounds) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	t.Run("Accept", func(t *testing.T) {
+		c := New([]int{0, 0, 1, 2, 3})
+		if Accept(c, 1, 2) {
+			t.Errorf("expected item not to be accepted")
+		}
+		if !Accept(c, 0) {
+			t.Errorf("expected item to be accepted")
+		}
+		if n := AcceptRun(c, 0, 1, 2); n != 3 {
+			t.Errorf("unexpected count: wanted %d ; got %d", 3, n)
+		}
+		if v := c.Cur(); v != 3 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 3, v)
+		}
+		c.Next()
+		if Accept(c, 0) {
+			t.Errorf("expected EOF")
+		}
+	})
+	t.Run("Runes", func(t *testing.T) {
+		c := Runes("héllo wörld")
+		if v := string(TakeWhile[rune](c, unicode.IsLetter)); v != "héllo" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "héllo", v)
+		}
+	})
+	t.Run("Ring", func(t *testing.T) {
+		all := func(int) bool { return true }
+		for _, test := range []struct {
+			name string
+			c    Cursor[int]
+		}{
+			{"Ring", Ring([]int{1, 1, 1})},
+			{"Sync", Sync(Ring([]int{1, 1, 1}))},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				c := test.c
+				if n := SkipWhile(c, all); n != 3 {
+					t.Errorf("unexpected count: wanted %d ; got %d", 3, n)
+				}
+				if n := AcceptRun(c, 1); n != 3 {
+					t.Errorf("unexpected count: wanted %d ; got %d", 3, n)
+				}
+				c.Idx(1)
+				if v := TakeWhile(c, all); len(v) != 2 {
+					t.Errorf("unexpected length: wanted %d ; got %d", 2, len(v))
+				}
+				if v := Until(c, func(int) bool { return false }); len(v) != 2 {
+					t.Errorf("unexpected length: wanted %d ; got %d", 2, len(v))
+				}
+				if c.Pos() != 1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+				}
+			})
+		}
+	})
+}
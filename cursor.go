@@ -1,5 +1,7 @@
 package cur
 
+import "iter"
+
 // Cursor navigates through a slice in a controlled manner, allowing the
 // caller to move forward, backwards, and jump around the slice as they need
 type Cursor[T any] interface {
@@ -60,30 +62,76 @@ type Cursor[T any] interface {
 
 	// Extract returns a slice from index `start` to index `end`
 	Extract(start, end int) []T
+
+	// Mark returns a snapshot of the cursor's current position, which can be restored
+	// with Reset
+	Mark() Mark
+
+	// Reset returns the cursor to the position captured in the Mark `m`
+	Reset(m Mark)
+
+	// Begin opens a transaction, saving the cursor's current position. Transactions
+	// can be nested, each call to Begin requiring its own Commit or Rollback
+	Begin()
+
+	// Commit closes the innermost transaction, keeping the cursor's current position
+	//
+	// Returns ErrNoTransaction if there are no open transactions
+	Commit() error
+
+	// Rollback closes the innermost transaction, returning the cursor to the position
+	// it was in when the transaction was opened
+	//
+	// Returns ErrNoTransaction if there are no open transactions
+	Rollback() error
+
+	// All returns an iterator over the index and item pairs in the slice, moving the
+	// cursor to its head and walking forward until the end of the slice
+	//
+	// The cursor is left on the item where the iteration stopped
+	All() iter.Seq2[int, T]
+
+	// Forward returns an iterator over the index and item pairs in the slice, walking
+	// forward from the cursor's current position until the end of the slice
+	//
+	// The cursor is left on the item where the iteration stopped
+	Forward() iter.Seq2[int, T]
+
+	// Backward returns an iterator over the index and item pairs in the slice, walking
+	// backwards from the cursor's current position until the head of the slice
+	//
+	// The cursor is left on the item where the iteration stopped
+	Backward() iter.Seq2[int, T]
+
+	// Clone returns a new, independent cursor in the same position and over the same
+	// data as this one. Open transactions are not carried over to the clone
+	Clone() Cursor[T]
 }
 
 type cursor[T any] struct {
-	slice []T
-	pos   int
+	slice  []T
+	pos    int
+	strict bool
+	err    error
+	tx     txStack
 }
 
 // New returns a Cursor for the input slice, or nil if the slice is empty
-func New[T any](slice []T) Cursor[T] {
+func New[T any](slice []T, opts ...Option) Cursor[T] {
 	if len(slice) == 0 {
 		return nil
 	}
+	cfg := newConfig(opts...)
 	return &cursor[T]{
-		slice: slice,
+		slice:  slice,
+		strict: cfg.strict,
 	}
 }
 
 // Cur returns the item in the current position
 func (c *cursor[T]) Cur() T {
-	if c.pos >= len(c.slice) {
-		var eof T
-		return eof
-	}
-	return c.slice[c.pos]
+	v, _ := c.CurOK()
+	return v
 }
 
 // Pos returns the current position in the cursor
@@ -101,34 +149,23 @@ func (c *cursor[T]) Len() int {
 
 // Next advances the cursor, returning the next item in the slice
 func (c *cursor[T]) Next() T {
-	if c.pos >= len(c.slice) {
-		var eof T
-		return eof
-	}
-	c.pos++
-	return c.slice[c.pos-1]
+	v, _ := c.NextOK()
+	return v
 }
 
 // Prev returns the previous item in the slice, or the zero-value for T as EOF if
 // index is / would be less than zero
 func (c *cursor[T]) Prev() T {
-	if c.pos <= 0 {
-		var eof T
-		return eof
-	}
-	c.pos--
-	return c.slice[c.pos]
+	v, _ := c.PrevOK()
+	return v
 }
 
 // Peek returns the next indexed item without advancing the cursor
 //
 // If the next token overflows the slice, returns the zero-value for T as EOF
 func (c *cursor[T]) Peek() T {
-	if c.pos+1 >= len(c.slice) {
-		var eof T
-		return eof
-	}
-	return c.slice[c.pos+1]
+	v, _ := c.PeekOK()
+	return v
 }
 
 // Head returns to the beginning of the slice
@@ -148,12 +185,8 @@ func (c *cursor[T]) Tail() T {
 // If the input index is below 0, the zero-value for T as EOF
 // If the input index is greater than the size of the slice, the zero-value for T as EOF
 func (c *cursor[T]) Idx(idx int) T {
-	if idx < 0 || idx >= len(c.slice) {
-		var eof T
-		return eof
-	}
-	c.pos = idx
-	return c.slice[idx]
+	v, _ := c.IdxOK(idx)
+	return v
 }
 
 // Offset advances or rewinds `amount` steps in the slice, be it a positive or negative
@@ -162,12 +195,8 @@ func (c *cursor[T]) Idx(idx int) T {
 // If the result offset is below 0, the zero-value for T as EOF
 // If the result offset is greater than the size of the slice, the zero-value for T as EOF
 func (c *cursor[T]) Offset(amount int) T {
-	if c.pos+amount < 0 || c.pos+amount >= len(c.slice) {
-		var eof T
-		return eof
-	}
-	c.pos += amount
-	return c.slice[c.pos]
+	v, _ := c.OffsetOK(amount)
+	return v
 }
 
 // PeekIdx returns the next indexed item without advancing the cursor,
@@ -176,11 +205,8 @@ func (c *cursor[T]) Offset(amount int) T {
 // If the input index is below 0, the zero-value for T as EOF
 // If the input index is greater than the size of the slice, the zero-value for T as EOF
 func (c *cursor[T]) PeekIdx(idx int) T {
-	if idx < 0 || idx >= len(c.slice)-1 {
-		var eof T
-		return eof
-	}
-	return c.slice[idx]
+	v, _ := c.PeekIdxOK(idx)
+	return v
 }
 
 // PeekOffset returns the next indexed item without advancing the cursor,
@@ -189,11 +215,8 @@ func (c *cursor[T]) PeekIdx(idx int) T {
 // If the result offset is below 0, the zero-value for T as EOF
 // If the result offset is greater than the size of the slice, the zero-value for T as EOF
 func (c *cursor[T]) PeekOffset(amount int) T {
-	if c.pos+amount < 0 || c.pos+amount >= len(c.slice)-1 {
-		var eof T
-		return eof
-	}
-	return c.slice[c.pos+amount]
+	v, _ := c.PeekOffsetOK(amount)
+	return v
 }
 
 // Extract returns a slice from index `start` to index `end`
@@ -210,3 +233,171 @@ func (c *cursor[T]) Extract(start, end int) []T {
 
 	return c.slice[start:end]
 }
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *cursor[T]) Clone() Cursor[T] {
+	return &cursor[T]{
+		slice:  c.slice,
+		pos:    c.pos,
+		strict: c.strict,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *cursor[T]) Mark() Mark {
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *cursor[T]) Reset(m Mark) {
+	c.pos = clamp(int(m), len(c.slice))
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *cursor[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *cursor[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *cursor[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the slice, moving the
+// cursor to its head and walking forward until the end of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *cursor[T]) All() iter.Seq2[int, T] {
+	return all[T](c)
+}
+
+// Forward returns an iterator over the index and item pairs in the slice, walking
+// forward from the cursor's current position until the end of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *cursor[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](c)
+}
+
+// Backward returns an iterator over the index and item pairs in the slice, walking
+// backwards from the cursor's current position until the head of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *cursor[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](c)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the slice
+func (c *cursor[T]) CurOK() (T, bool) {
+	return c.at("Cur", c.pos)
+}
+
+// NextOK advances the cursor, returning the next item in the slice and whether
+// it was within the bounds of the slice
+func (c *cursor[T]) NextOK() (T, bool) {
+	v, ok := c.at("Next", c.pos)
+	if ok {
+		c.pos++
+	}
+	return v, ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the slice and whether
+// it was within the bounds of the slice
+func (c *cursor[T]) PrevOK() (T, bool) {
+	v, ok := c.at("Prev", c.pos-1)
+	if ok {
+		c.pos--
+	}
+	return v, ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the slice
+func (c *cursor[T]) PeekOK() (T, bool) {
+	return c.at("Peek", c.pos+1)
+}
+
+// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+// whether `idx` was within the bounds of the slice
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *cursor[T]) IdxOK(idx int) (T, bool) {
+	return c.jump("Idx", idx)
+}
+
+// OffsetOK advances or rewinds `amount` steps in the slice, returning the item and
+// whether the result offset was within the bounds of the slice
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *cursor[T]) OffsetOK(amount int) (T, bool) {
+	return c.jump("Offset", c.pos+amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the slice
+func (c *cursor[T]) PeekIdxOK(idx int) (T, bool) {
+	return c.at("PeekIdx", idx)
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the slice
+func (c *cursor[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.at("PeekOffset", c.pos+amount)
+}
+
+// Err returns the *BoundsError raised by the last navigation call, if the cursor
+// was created with the WithStrict option
+func (c *cursor[T]) Err() error {
+	return c.err
+}
+
+// mutatesOnRead returns whether the read-only methods record errors
+func (c *cursor[T]) mutatesOnRead() bool {
+	return c.strict
+}
+
+// at returns the item in index `idx` and whether it is within the bounds of the slice,
+// recording a *BoundsError for the operation `op` on strict cursors
+func (c *cursor[T]) at(op string, idx int) (T, bool) {
+	if idx < 0 || idx >= len(c.slice) {
+		if c.strict {
+			c.err = &BoundsError{Op: op, Index: idx, Len: len(c.slice)}
+		}
+		var eof T
+		return eof, false
+	}
+	if c.strict {
+		c.err = nil
+	}
+	return c.slice[idx], true
+}
+
+// jump moves the cursor to index `idx` if it is within the bounds of the slice
+func (c *cursor[T]) jump(op string, idx int) (T, bool) {
+	v, ok := c.at(op, idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
@@ -0,0 +1,374 @@
+package cur
+
+import "iter"
+
+// Editor is a Cursor over a mutable sequence of items, which can be edited at the cursor's
+// current position. It is backed by a gap buffer, so consecutive edits around the same
+// position take amortized constant time
+type Editor[T any] interface {
+	CheckedCursor[T]
+	Marker[T]
+
+	// Insert adds `items` before the current position, leaving the cursor on the same item
+	Insert(items ...T)
+
+	// Delete removes up to `n` items starting at the current position, returning the number
+	// of removed items
+	Delete(n int) int
+
+	// Backspace removes up to `n` items before the current position, returning the number
+	// of removed items
+	Backspace(n int) int
+
+	// Replace removes up to `n` items starting at the current position and inserts `items`
+	// in their place, leaving the cursor after them. It returns the number of removed items
+	Replace(n int, items ...T) int
+
+	// Items returns a copy of the items in the Editor
+	Items() []T
+
+	// Undo reverts the last group of edits, returning the cursor to where it was before
+	// them. It returns false if there is nothing to undo
+	//
+	// Any open group is closed before undoing
+	Undo() bool
+
+	// Redo reapplies the last group of undone edits, returning the cursor to where it was
+	// after them. It returns false if there is nothing to redo
+	//
+	// Any open group is closed before redoing
+	Redo() bool
+
+	// BeginGroup opens a group of edits, which are undone and redone as a single unit once
+	// closed with EndGroup. Groups can be nested, in which case only the outermost group
+	// is recorded as a unit
+	BeginGroup()
+
+	// EndGroup closes the innermost group of edits opened with BeginGroup
+	EndGroup()
+}
+
+type editor[T any] struct {
+	buf   *gapBuffer[T]
+	pos   int
+	tx    txStack
+	hist  *history[T]
+	marks marks
+}
+
+// NewEditor returns an Editor over a copy of the input slice
+//
+// Unlike New, an Editor is returned even if the slice is empty, so that items can be
+// inserted into it. Every edit is recorded so that it can be undone, which can be
+// limited with the WithHistory option
+func NewEditor[T any](slice []T, opts ...Option) Editor[T] {
+	cfg := newConfig(opts...)
+	e := &editor[T]{
+		buf:   newGapBuffer(slice),
+		hist:  &history[T]{limit: -1},
+		marks: marks{},
+	}
+	if cfg.limitHistory {
+		e.hist.limit = cfg.history
+	}
+	return e
+}
+
+// Insert adds `items` before the current position, leaving the cursor on the same item
+func (c *editor[T]) Insert(items ...T) {
+	c.pos = c.position()
+	c.splice(c.pos, 0, items, c.pos+len(items))
+}
+
+// Delete removes up to `n` items starting at the current position, returning the number
+// of removed items
+func (c *editor[T]) Delete(n int) int {
+	c.pos = c.position()
+	n = clamp(n, c.buf.len()-c.pos)
+	c.splice(c.pos, n, nil, c.pos)
+	return n
+}
+
+// Backspace removes up to `n` items before the current position, returning the number
+// of removed items
+func (c *editor[T]) Backspace(n int) int {
+	c.pos = c.position()
+	n = clamp(n, c.pos)
+	c.splice(c.pos-n, n, nil, c.pos-n)
+	return n
+}
+
+// Replace removes up to `n` items starting at the current position and inserts `items`
+// in their place, leaving the cursor after them. It returns the number of removed items
+func (c *editor[T]) Replace(n int, items ...T) int {
+	c.pos = c.position()
+	n = clamp(n, c.buf.len()-c.pos)
+	c.splice(c.pos, n, items, c.pos+len(items))
+	return n
+}
+
+// Items returns a copy of the items in the Editor
+func (c *editor[T]) Items() []T {
+	items := make([]T, c.buf.len())
+	n := copy(items, c.buf.buf[:c.buf.start])
+	copy(items[n:], c.buf.buf[c.buf.end:])
+	return items
+}
+
+// Cur returns the item in the current position
+func (c *editor[T]) Cur() T {
+	v, _ := c.CurOK()
+	return v
+}
+
+// Pos returns the current position in the cursor
+func (c *editor[T]) Pos() int {
+	if c.pos >= c.buf.len() {
+		return -1
+	}
+	return c.pos
+}
+
+// Len returns the total number of items in the Editor
+func (c *editor[T]) Len() int {
+	return c.buf.len()
+}
+
+// Next advances the cursor, returning the next item, or the zero-value for T as EOF
+func (c *editor[T]) Next() T {
+	v, _ := c.NextOK()
+	return v
+}
+
+// Prev returns the previous item, or the zero-value for T as EOF if
+// index is / would be less than zero
+func (c *editor[T]) Prev() T {
+	v, _ := c.PrevOK()
+	return v
+}
+
+// Peek returns the next indexed item without advancing the cursor
+//
+// If the next token overflows the Editor, returns the zero-value for T as EOF
+func (c *editor[T]) Peek() T {
+	v, _ := c.PeekOK()
+	return v
+}
+
+// Head returns to the beginning of the Editor
+func (c *editor[T]) Head() T {
+	c.pos = 0
+	return c.Next()
+}
+
+// Tail jumps to the end of the Editor
+func (c *editor[T]) Tail() T {
+	c.pos = c.buf.len()
+	return c.Prev()
+}
+
+// Idx jumps to the specific index `idx` in the Editor
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the Editor, the zero-value for T as EOF
+func (c *editor[T]) Idx(idx int) T {
+	v, _ := c.IdxOK(idx)
+	return v
+}
+
+// Offset advances or rewinds `amount` steps in the Editor, be it a positive or negative
+// input.
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the size of the Editor, the zero-value for T as EOF
+func (c *editor[T]) Offset(amount int) T {
+	v, _ := c.OffsetOK(amount)
+	return v
+}
+
+// PeekIdx returns the item in index `idx` without moving the cursor
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the Editor, the zero-value for T as EOF
+func (c *editor[T]) PeekIdx(idx int) T {
+	v, _ := c.PeekIdxOK(idx)
+	return v
+}
+
+// PeekOffset returns the item `amount` steps away without moving the cursor
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the size of the Editor, the zero-value for T as EOF
+func (c *editor[T]) PeekOffset(amount int) T {
+	v, _ := c.PeekOffsetOK(amount)
+	return v
+}
+
+// Extract returns a slice from index `start` to index `end`
+//
+// The returned slice shares the Editor's buffer, so it is only valid until the next edit
+func (c *editor[T]) Extract(start, end int) []T {
+	if start < 0 {
+		start = 0
+	}
+	if end > c.buf.len() {
+		end = c.buf.len()
+	}
+	for start > end {
+		start--
+	}
+
+	return c.buf.slice(start, end)
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+//
+// The clone shares the buffer, the undo history and the marks with this Editor, so edits
+// through either of them are visible to both
+func (c *editor[T]) Clone() Cursor[T] {
+	return &editor[T]{
+		buf:   c.buf,
+		pos:   c.position(),
+		hist:  c.hist,
+		marks: c.marks,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *editor[T]) Mark() Mark {
+	return Mark(c.position())
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *editor[T]) Reset(m Mark) {
+	c.pos = clamp(int(m), c.buf.len())
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *editor[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *editor[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *editor[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the Editor, moving the
+// cursor to its head and walking forward until the end of the Editor
+//
+// The cursor is left on the item where the iteration stopped
+func (c *editor[T]) All() iter.Seq2[int, T] {
+	return all[T](c)
+}
+
+// Forward returns an iterator over the index and item pairs in the Editor, walking
+// forward from the cursor's current position until the end of the Editor
+//
+// The cursor is left on the item where the iteration stopped
+func (c *editor[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](c)
+}
+
+// Backward returns an iterator over the index and item pairs in the Editor, walking
+// backwards from the cursor's current position until the head of the Editor
+//
+// The cursor is left on the item where the iteration stopped
+func (c *editor[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](c)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the Editor
+func (c *editor[T]) CurOK() (T, bool) {
+	return c.PeekIdxOK(c.position())
+}
+
+// NextOK advances the cursor, returning the next item and whether it was within
+// the bounds of the Editor
+func (c *editor[T]) NextOK() (T, bool) {
+	c.pos = c.position()
+	v, ok := c.PeekIdxOK(c.pos)
+	if ok {
+		c.pos++
+	}
+	return v, ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item and whether it was within
+// the bounds of the Editor
+func (c *editor[T]) PrevOK() (T, bool) {
+	c.pos = c.position()
+	v, ok := c.PeekIdxOK(c.pos - 1)
+	if ok {
+		c.pos--
+	}
+	return v, ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the Editor
+func (c *editor[T]) PeekOK() (T, bool) {
+	return c.PeekIdxOK(c.position() + 1)
+}
+
+// IdxOK jumps to the specific index `idx` in the Editor, returning the item and
+// whether `idx` was within its bounds
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *editor[T]) IdxOK(idx int) (T, bool) {
+	v, ok := c.PeekIdxOK(idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
+
+// OffsetOK advances or rewinds `amount` steps in the Editor, returning the item and
+// whether the result offset was within its bounds
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *editor[T]) OffsetOK(amount int) (T, bool) {
+	return c.IdxOK(c.position() + amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the Editor
+func (c *editor[T]) PeekIdxOK(idx int) (T, bool) {
+	if idx < 0 || idx >= c.buf.len() {
+		var eof T
+		return eof, false
+	}
+	return c.buf.at(idx), true
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the Editor
+func (c *editor[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.PeekIdxOK(c.position() + amount)
+}
+
+// position returns the cursor's position, limited to the end of the buffer, as it may
+// have been shrunk through a clone of this Editor
+func (c *editor[T]) position() int {
+	return min(c.pos, c.buf.len())
+}
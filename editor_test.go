@@ -0,0 +1,158 @@
+package cur
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestEditor(t *testing.T) {
+	t.Run("Insert", func(t *testing.T) {
+		e := NewEditor([]rune("hello world"))
+		e.Idx(5)
+		e.Insert([]rune(",")...)
+		if v := e.Cur(); v != ' ' {
+			t.Errorf("unexpected value: wanted %q ; got %q", ' ', v)
+		}
+		e.Tail()
+		e.Next()
+		e.Insert('!')
+		if got := string(e.Items()); got != "hello, world!" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "hello, world!", got)
+		}
+		if e.Pos() != -1 || e.Len() != 13 {
+			t.Errorf("unexpected position %d and length %d", e.Pos(), e.Len())
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		e := NewEditor([]rune("hello, world"))
+		e.Idx(5)
+		if n := e.Delete(2); n != 2 {
+			t.Errorf("unexpected removed items: wanted %d ; got %d", 2, n)
+		}
+		if v := e.Cur(); v != 'w' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'w', v)
+		}
+		if n := e.Delete(10); n != 5 {
+			t.Errorf("unexpected removed items: wanted %d ; got %d", 5, n)
+		}
+		if got := string(e.Items()); got != "hello" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "hello", got)
+		}
+	})
+	t.Run("Backspace", func(t *testing.T) {
+		e := NewEditor([]rune("hello, world"))
+		e.Idx(7)
+		if n := e.Backspace(2); n != 2 {
+			t.Errorf("unexpected removed items: wanted %d ; got %d", 2, n)
+		}
+		if v := e.Cur(); v != 'w' || e.Pos() != 5 {
+			t.Errorf("unexpected value: wanted %q at %d ; got %q at %d", 'w', 5, v, e.Pos())
+		}
+		if n := e.Backspace(10); n != 5 {
+			t.Errorf("unexpected removed items: wanted %d ; got %d", 5, n)
+		}
+		if got := string(e.Items()); got != "world" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "world", got)
+		}
+	})
+	t.Run("Replace", func(t *testing.T) {
+		e := NewEditor([]string{"a", "+", "b", ";"})
+		e.Idx(1)
+		if n := e.Replace(1, "-", "-"); n != 1 {
+			t.Errorf("unexpected removed items: wanted %d ; got %d", 1, n)
+		}
+		if v := e.Cur(); v != "b" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "b", v)
+		}
+		if got := e.Items(); !slices.Equal(got, []string{"a", "-", "-", "b", ";"}) {
+			t.Errorf("unexpected output: %v", got)
+		}
+	})
+	t.Run("Extract", func(t *testing.T) {
+		e := NewEditor([]rune("hello world"))
+		e.Idx(5)
+		e.Insert([]rune(" there")...)
+		if got := string(e.Extract(0, 5)); got != "hello" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "hello", got)
+		}
+		if got := string(e.Extract(3, 14)); got != "lo there wo" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "lo there wo", got)
+		}
+		if got := string(e.Extract(12, 20)); got != "world" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "world", got)
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		e := NewEditor[int](nil)
+		if _, ok := e.CurOK(); ok {
+			t.Errorf("expected EOF")
+		}
+		e.Insert(1, 2, 3)
+		if got := e.Items(); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("unexpected output: %v", got)
+		}
+	})
+	t.Run("SharedClone", func(t *testing.T) {
+		e := NewEditor([]rune("hello world"))
+		e.Tail()
+		e.Next()
+		c := e.Clone().(Editor[rune])
+		c.Head()
+		c.Delete(3)
+
+		if _, ok := e.CurOK(); ok {
+			t.Errorf("expected EOF")
+		}
+		if n := e.Delete(1); n != 0 {
+			t.Errorf("unexpected count: wanted %d ; got %d", 0, n)
+		}
+		e.Insert('!')
+		if got := string(e.Items()); got != "ho world!" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "ho world!", got)
+		}
+		if n := e.Backspace(1); n != 1 {
+			t.Errorf("unexpected count: wanted %d ; got %d", 1, n)
+		}
+		if v := e.Prev(); v != 'd' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'd', v)
+		}
+		if e.Pos() != 7 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 7, e.Pos())
+		}
+	})
+	t.Run("Random", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		model := []int{}
+		e := NewEditor(model)
+
+		for i := range 2000 {
+			pos := rng.IntN(len(model) + 1)
+			e.Reset(Mark(pos))
+			switch rng.IntN(4) {
+			case 0, 1:
+				items := make([]int, rng.IntN(40))
+				for j := range items {
+					items[j] = i*100 + j
+				}
+				e.Insert(items...)
+				model = slices.Insert(model, pos, items...)
+			case 2:
+				n := e.Delete(rng.IntN(20))
+				model = slices.Delete(model, pos, pos+n)
+			case 3:
+				n := e.Backspace(rng.IntN(20))
+				model = slices.Delete(model, pos-n, pos)
+			}
+
+			if !slices.Equal(e.Items(), model) {
+				t.Fatalf("unexpected contents after %d edits", i+1)
+			}
+			start := rng.IntN(len(model) + 1)
+			end := start + rng.IntN(len(model)-start+1)
+			if !slices.Equal(e.Extract(start, end), model[start:end]) {
+				t.Fatalf("unexpected extract [%d:%d] after %d edits", start, end, i+1)
+			}
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package cur
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrOutOfBounds is the sentinel error wrapped by a *BoundsError
+	ErrOutOfBounds = errors.New("cur: index out of bounds")
+
+	// ErrNoTransaction is returned when committing or rolling back a cursor without
+	// open transactions
+	ErrNoTransaction = errors.New("cur: no open transaction")
+
+	// ErrResized is the sentinel error wrapped by a *ResizeError
+	ErrResized = errors.New("cur: slice changed")
+
+	// ErrUnexpected is the sentinel error wrapped by an *UnexpectedError
+	ErrUnexpected = errors.New("cur: unexpected item")
+)
+
+// BoundsError describes a navigation call that fell out of the bounds of the slice
+type BoundsError struct {
+	// Op is the name of the Cursor method that failed, such as "Next" or "PeekIdx"
+	Op string
+	// Index is the offending index
+	Index int
+	// Len is the length of the slice at the time of the call
+	Len int
+}
+
+// Error implements the error interface
+func (e *BoundsError) Error() string {
+	return fmt.Sprintf("cur: %s: index %d out of bounds with length %d", e.Op, e.Index, e.Len)
+}
+
+// Unwrap returns ErrOutOfBounds
+func (e *BoundsError) Unwrap() error {
+	return ErrOutOfBounds
+}
+
+// ResizeError describes a change to the slice of a Ptr cursor that invalidated its
+// position, recorded under the ErrorOnResize policy
+type ResizeError struct {
+	// Pos is the cursor's position when the change was detected
+	Pos int
+	// OldLen is the length of the slice before the change
+	OldLen int
+	// NewLen is the length of the slice after the change
+	NewLen int
+	// Replaced reports whether the slice was replaced with a different backing array
+	Replaced bool
+}
+
+// Error implements the error interface
+func (e *ResizeError) Error() string {
+	if e.Replaced {
+		return fmt.Sprintf("cur: slice replaced with length %d at position %d", e.NewLen, e.Pos)
+	}
+	return fmt.Sprintf("cur: slice shrank from length %d to %d below position %d", e.OldLen, e.NewLen, e.Pos)
+}
+
+// Unwrap returns ErrResized
+func (e *ResizeError) Unwrap() error {
+	return ErrResized
+}
+
+// UnexpectedError describes an item that didn't match the one expected by Expect
+type UnexpectedError struct {
+	// Index is the index of the unexpected item
+	Index int
+	// Want is the expected item
+	Want any
+	// Got is the item found in Index
+	Got any
+}
+
+// Error implements the error interface
+func (e *UnexpectedError) Error() string {
+	return fmt.Sprintf("cur: unexpected item in index %d: wanted %v ; got %v", e.Index, e.Want, e.Got)
+}
+
+// Unwrap returns ErrUnexpected
+func (e *UnexpectedError) Unwrap() error {
+	return ErrUnexpected
+}
+
+// Err returns the error raised by the last navigation call in Cursor `c`, or nil if it
+// succeeded or if the cursor doesn't report errors
+//
+// Cursors created with the WithStrict option report a *BoundsError whenever a call
+// falls out of the bounds of the slice
+func Err[T any](c Cursor[T]) error {
+	if e, ok := c.(interface{ Err() error }); ok {
+		return e.Err()
+	}
+	return nil
+}
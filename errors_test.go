@@ -0,0 +1,65 @@
+package cur
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStrict(t *testing.T) {
+	data := []int{1, 11, 21}
+
+	for _, test := range []struct {
+		name string
+		c    func(opts ...Option) Cursor[int]
+	}{
+		{"New", func(opts ...Option) Cursor[int] { return New(data, opts...) }},
+		{"Ptr", func(opts ...Option) Cursor[int] { return Ptr(&data, opts...) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Success", func(t *testing.T) {
+				c := test.c(WithStrict())
+				c.Next()
+				if err := Err(c); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			})
+			t.Run("OutOfBounds", func(t *testing.T) {
+				c := test.c(WithStrict())
+				if v := c.PeekIdx(5); v != eof {
+					t.Errorf("unexpected value: wanted %d ; got %d", eof, v)
+				}
+
+				err := Err(c)
+				if !errors.Is(err, ErrOutOfBounds) {
+					t.Errorf("unexpected error: wanted %v ; got %v", ErrOutOfBounds, err)
+				}
+				var bErr *BoundsError
+				if !errors.As(err, &bErr) {
+					t.Fatalf("expected a *BoundsError ; got %T", err)
+				}
+				if bErr.Op != "PeekIdx" || bErr.Index != 5 || bErr.Len != 3 {
+					t.Errorf("unexpected error fields: %+v", *bErr)
+				}
+
+				c.Tail()
+				c.Next()
+				c.Next()
+				if !errors.As(Err(c), &bErr) || bErr.Op != "Next" || bErr.Index != 3 {
+					t.Errorf("unexpected error: %v", Err(c))
+				}
+
+				c.Head()
+				if err := Err(c); err != nil {
+					t.Errorf("expected error to be cleared ; got %v", err)
+				}
+			})
+			t.Run("NotStrict", func(t *testing.T) {
+				c := test.c()
+				c.Idx(-1)
+				if err := Err(c); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			})
+		})
+	}
+}
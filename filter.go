@@ -0,0 +1,337 @@
+package cur
+
+import "iter"
+
+// FilterCursor is a Cursor over the items of another cursor that match a predicate,
+// which can map its positions back to the ones in the original cursor
+type FilterCursor[T any] interface {
+	CheckedCursor[T]
+
+	// SourceIndex returns the index in the original cursor of the item in index `idx`,
+	// or -1 if `idx` is out of bounds
+	SourceIndex(idx int) int
+}
+
+// filterIndex maps the items matching a predicate to their index in the source cursor,
+// built as the items are visited
+type filterIndex[T any] struct {
+	src  CheckedCursor[T]
+	pred func(T) bool
+	idx  []int
+	next int
+	done bool
+}
+
+// load scans the source until the item in index `idx` is mapped, returning whether
+// it exists
+func (f *filterIndex[T]) load(idx int) bool {
+	for !f.done && len(f.idx) <= idx {
+		v, ok := f.src.PeekIdxOK(f.next)
+		if !ok {
+			f.done = true
+			break
+		}
+		if f.pred(v) {
+			f.idx = append(f.idx, f.next)
+		}
+		f.next++
+	}
+	return idx >= 0 && idx < len(f.idx)
+}
+
+// len scans the whole source, returning the number of matching items
+func (f *filterIndex[T]) len() int {
+	for !f.done {
+		f.load(len(f.idx))
+	}
+	return len(f.idx)
+}
+
+type filter[T any] struct {
+	src *filterIndex[T]
+	pos int
+	tx  txStack
+}
+
+// Filter returns a FilterCursor over the items in the Cursor `c` that match `pred`, or
+// nil if either of them is nil
+//
+// The items are read with PeekIdx without moving `c`, and mapped to their index in `c` as
+// the cursor reaches them. Len scans all items in `c`. Changes to `c` after the items are
+// mapped are not reflected in the cursor
+func Filter[T any](c Cursor[T], pred func(T) bool) FilterCursor[T] {
+	if c == nil || pred == nil {
+		return nil
+	}
+	return &filter[T]{
+		src: &filterIndex[T]{src: Check(c), pred: pred},
+	}
+}
+
+// SourceIndex returns the index in the original cursor of the item in index `idx`,
+// or -1 if `idx` is out of bounds
+func (c *filter[T]) SourceIndex(idx int) int {
+	if !c.src.load(idx) {
+		return -1
+	}
+	return c.src.idx[idx]
+}
+
+// Cur returns the item in the current position
+func (c *filter[T]) Cur() T {
+	v, _ := c.CurOK()
+	return v
+}
+
+// Pos returns the current position in the cursor
+func (c *filter[T]) Pos() int {
+	if !c.src.load(c.pos) {
+		return -1
+	}
+	return c.pos
+}
+
+// Len returns the number of items matching the predicate
+func (c *filter[T]) Len() int {
+	return c.src.len()
+}
+
+// Next advances the cursor, returning the next item
+func (c *filter[T]) Next() T {
+	v, _ := c.NextOK()
+	return v
+}
+
+// Prev returns the previous item, or the zero-value for T as EOF if index is / would be
+// less than zero
+func (c *filter[T]) Prev() T {
+	v, _ := c.PrevOK()
+	return v
+}
+
+// Peek returns the next indexed item without advancing the cursor
+//
+// If the next token overflows the cursor, returns the zero-value for T as EOF
+func (c *filter[T]) Peek() T {
+	v, _ := c.PeekOK()
+	return v
+}
+
+// Head returns to the first item
+func (c *filter[T]) Head() T {
+	c.pos = 0
+	return c.Next()
+}
+
+// Tail jumps to the last item
+func (c *filter[T]) Tail() T {
+	c.pos = c.src.len()
+	return c.Prev()
+}
+
+// Idx jumps to the specific index `idx`
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the number of items, the zero-value for T as EOF
+func (c *filter[T]) Idx(idx int) T {
+	v, _ := c.IdxOK(idx)
+	return v
+}
+
+// Offset advances or rewinds `amount` steps, be it a positive or negative input.
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the number of items, the zero-value for T as EOF
+func (c *filter[T]) Offset(amount int) T {
+	v, _ := c.OffsetOK(amount)
+	return v
+}
+
+// PeekIdx returns the next indexed item without advancing the cursor,
+// with the index `idx`
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the number of items, the zero-value for T as EOF
+func (c *filter[T]) PeekIdx(idx int) T {
+	v, _ := c.PeekIdxOK(idx)
+	return v
+}
+
+// PeekOffset returns the next indexed item without advancing the cursor,
+// with offset `amount`
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the number of items, the zero-value for T as EOF
+func (c *filter[T]) PeekOffset(amount int) T {
+	v, _ := c.PeekOffsetOK(amount)
+	return v
+}
+
+// Extract returns a new slice with the items from index `start` to index `end`
+func (c *filter[T]) Extract(start, end int) []T {
+	start = max(start, 0)
+	items := make([]T, 0, max(end-start, 0))
+	for i := start; i < end; i++ {
+		v, ok := c.at(i)
+		if !ok {
+			break
+		}
+		items = append(items, v)
+	}
+	return items
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *filter[T]) Clone() Cursor[T] {
+	return &filter[T]{
+		src: c.src,
+		pos: c.pos,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *filter[T]) Mark() Mark {
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *filter[T]) Reset(m Mark) {
+	c.src.load(int(m))
+	c.pos = clamp(int(m), len(c.src.idx))
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *filter[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *filter[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *filter[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the cursor, moving it to
+// its head and walking forward until the last item
+//
+// The cursor is left on the item where the iteration stopped
+func (c *filter[T]) All() iter.Seq2[int, T] {
+	return all[T](c)
+}
+
+// Forward returns an iterator over the index and item pairs in the cursor, walking
+// forward from its current position until the last item
+//
+// The cursor is left on the item where the iteration stopped
+func (c *filter[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](c)
+}
+
+// Backward returns an iterator over the index and item pairs in the cursor, walking
+// backwards from its current position until the first item
+//
+// The cursor is left on the item where the iteration stopped
+func (c *filter[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](c)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within its bounds
+func (c *filter[T]) CurOK() (T, bool) {
+	return c.at(c.pos)
+}
+
+// NextOK advances the cursor, returning the next item and whether it was within
+// the cursor's bounds
+func (c *filter[T]) NextOK() (T, bool) {
+	v, ok := c.at(c.pos)
+	if ok {
+		c.pos++
+	}
+	return v, ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item and whether it was within
+// the cursor's bounds
+func (c *filter[T]) PrevOK() (T, bool) {
+	v, ok := c.at(c.pos - 1)
+	if ok {
+		c.pos--
+	}
+	return v, ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the cursor's bounds
+func (c *filter[T]) PeekOK() (T, bool) {
+	return c.at(c.pos + 1)
+}
+
+// IdxOK jumps to the specific index `idx`, returning the item and whether `idx` was
+// within the cursor's bounds
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *filter[T]) IdxOK(idx int) (T, bool) {
+	return c.jump(idx)
+}
+
+// OffsetOK advances or rewinds `amount` steps, returning the item and whether the
+// result offset was within the cursor's bounds
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *filter[T]) OffsetOK(amount int) (T, bool) {
+	return c.jump(c.pos + amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the cursor's bounds
+func (c *filter[T]) PeekIdxOK(idx int) (T, bool) {
+	return c.at(idx)
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the cursor's bounds
+func (c *filter[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.at(c.pos + amount)
+}
+
+// mutatesOnRead returns true, as the read-only methods may extend the index map
+func (c *filter[T]) mutatesOnRead() bool {
+	return true
+}
+
+// at returns the item in index `idx` and whether it is within the cursor's bounds
+func (c *filter[T]) at(idx int) (T, bool) {
+	if !c.src.load(idx) {
+		var zero T
+		return zero, false
+	}
+	return c.src.src.PeekIdxOK(c.src.idx[idx])
+}
+
+// jump moves the cursor to index `idx` if it is within the cursor's bounds
+func (c *filter[T]) jump(idx int) (T, bool) {
+	v, ok := c.at(idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
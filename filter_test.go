@@ -0,0 +1,127 @@
+package cur
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	tokens := []string{"let", " ", "x", " ", "=", "\n", "1", " "}
+	notSpace := func(s string) bool { return strings.TrimSpace(s) != "" }
+
+	t.Run("Nil", func(t *testing.T) {
+		if Filter[int](nil, func(int) bool { return true }) != nil || Filter(New(tokens), nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("Navigation", func(t *testing.T) {
+		c := Filter(New(tokens), notSpace)
+		for _, want := range []string{"let", "x", "=", "1"} {
+			if v := c.Next(); v != want {
+				t.Errorf("unexpected value: wanted %q ; got %q", want, v)
+			}
+		}
+		if _, ok := c.NextOK(); ok {
+			t.Errorf("expected EOF")
+		}
+		if c.Pos() != -1 || c.Len() != 4 {
+			t.Errorf("unexpected position: wanted %d ; got %d (length %d)", -1, c.Pos(), c.Len())
+		}
+		if v := c.Prev(); v != "1" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "1", v)
+		}
+		if v := c.Idx(1); v != "x" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "x", v)
+		}
+		if v := c.Peek(); v != "=" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "=", v)
+		}
+		if v := c.Tail(); v != "1" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "1", v)
+		}
+		if v := c.Head(); v != "let" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "let", v)
+		}
+		if v := c.Extract(1, 9); !slices.Equal(v, []string{"x", "=", "1"}) {
+			t.Errorf("unexpected value: wanted %v ; got %v", []string{"x", "=", "1"}, v)
+		}
+	})
+	t.Run("SourceIndex", func(t *testing.T) {
+		c := Filter(New(tokens), notSpace)
+		for i, want := range []int{0, 2, 4, 6, -1} {
+			if idx := c.SourceIndex(i); idx != want {
+				t.Errorf("unexpected index: wanted %d ; got %d", want, idx)
+			}
+		}
+		if idx := c.SourceIndex(-1); idx != -1 {
+			t.Errorf("unexpected index: wanted %d ; got %d", -1, idx)
+		}
+	})
+	t.Run("Lazy", func(t *testing.T) {
+		var pulled int
+		src := Lazy(func() (int, bool) {
+			pulled++
+			return pulled, pulled <= 100
+		})
+		c := Filter[int](src, func(v int) bool { return v%10 == 0 })
+
+		if v := c.Idx(1); v != 20 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 20, v)
+		}
+		if pulled != 20 {
+			t.Errorf("unexpected pulled items: wanted %d ; got %d", 20, pulled)
+		}
+		if src.Pos() != 0 {
+			t.Errorf("unexpected source position: wanted %d ; got %d", 0, src.Pos())
+		}
+		if c.Len() != 10 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 10, c.Len())
+		}
+	})
+	t.Run("Helpers", func(t *testing.T) {
+		c := Filter(New(tokens), notSpace)
+		if !Consume[string](c, []string{"let", "x", "="}) {
+			t.Errorf("expected a match")
+		}
+		if idx := c.SourceIndex(c.Pos()); idx != 6 {
+			t.Errorf("unexpected index: wanted %d ; got %d", 6, idx)
+		}
+
+		clone := c.Clone()
+		clone.Head()
+		if c.Pos() != 3 || clone.Pos() != 1 {
+			t.Errorf("unexpected positions: wanted %d, %d ; got %d, %d", 3, 1, c.Pos(), clone.Pos())
+		}
+
+		var items []string
+		for _, v := range c.Backward() {
+			items = append(items, v)
+		}
+		if !slices.Equal(items, []string{"1", "=", "x", "let"}) {
+			t.Errorf("unexpected items: %v", items)
+		}
+	})
+	t.Run("Sync", func(t *testing.T) {
+		data := make([]int, 1000)
+		for i := range data {
+			data[i] = i
+		}
+		c := Sync[int](Filter(New(data), func(v int) bool { return v%2 == 0 }))
+
+		var wg sync.WaitGroup
+		for i := range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for idx := i; idx < 500; idx += 4 {
+					if v := c.PeekIdx(idx); v != idx*2 {
+						t.Errorf("unexpected value: wanted %d ; got %d", idx*2, v)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,12 @@
+package cur
+
+// Fork returns a clone of the Cursor `c`, for a sub-parser to explore ahead without
+// disturbing `c`, along with a join function that moves `c` to the clone's position
+//
+// If the exploration fails, the clone can simply be discarded
+func Fork[T any](c Cursor[T]) (fork Cursor[T], join func()) {
+	fork = c.Clone()
+	return fork, func() {
+		c.Reset(fork.Mark())
+	}
+}
@@ -0,0 +1,96 @@
+package cur
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	data := []int{1, 11, 21, 31}
+
+	for _, test := range []struct {
+		name string
+		c    func() Cursor[int]
+	}{
+		{"New", func() Cursor[int] { return New(data) }},
+		{"Ptr", func() Cursor[int] { d := slices.Clone(data); return Ptr(&d) }},
+		{"Ring", func() Cursor[int] { return Ring(data) }},
+		{"Lazy", func() Cursor[int] { return LazySeq(slices.Values(data)) }},
+		{"Editor", func() Cursor[int] { return NewEditor(data) }},
+		{"Sync", func() Cursor[int] { return Sync(New(data)) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := test.c()
+			c.Next()
+			c.Begin()
+
+			clone := c.Clone()
+			if clone.Cur() != data[1] {
+				t.Errorf("unexpected value: wanted %d ; got %d", data[1], clone.Cur())
+			}
+			clone.Next()
+			clone.Next()
+			if c.Cur() != data[1] {
+				t.Errorf("unexpected value: wanted %d ; got %d", data[1], c.Cur())
+			}
+			if err := clone.Rollback(); err == nil {
+				t.Errorf("expected transactions not to be carried over to the clone")
+			}
+			if err := c.Rollback(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("SharedPtr", func(t *testing.T) {
+		d := slices.Clone(data)
+		c := Ptr(&d)
+		clone := c.Clone()
+		d = append(d, 41)
+		if v := clone.Tail(); v != 41 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 41, v)
+		}
+	})
+	t.Run("SharedEditor", func(t *testing.T) {
+		e := NewEditor(data)
+		clone := e.Clone()
+		e.Insert(0)
+		if clone.Len() != 5 || clone.Cur() != 0 {
+			t.Errorf("expected edits to be visible in the clone")
+		}
+	})
+	t.Run("Text", func(t *testing.T) {
+		c := Text("a\nb\nc")
+		c.Idx(2)
+		clone, ok := c.Clone().(TextCursor)
+		if !ok {
+			t.Fatalf("expected a TextCursor ; got %T", c.Clone())
+		}
+		clone.Next()
+		clone.Next()
+		if p := clone.Position(); p.String() != "3:1" {
+			t.Errorf("unexpected position: wanted %s ; got %s", "3:1", p)
+		}
+		if p := c.Position(); p.String() != "2:1" {
+			t.Errorf("unexpected position: wanted %s ; got %s", "2:1", p)
+		}
+	})
+}
+
+func TestFork(t *testing.T) {
+	c := Runes("let x = 1")
+
+	fork, join := Fork[rune](c)
+	for fork.Cur() != '=' {
+		fork.Next()
+	}
+	if c.Pos() != 0 {
+		t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+	}
+
+	join()
+	if c.Pos() != strings.IndexByte("let x = 1", '=') {
+		t.Errorf("unexpected position: wanted %d ; got %d", 6, c.Pos())
+	}
+}
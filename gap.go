@@ -0,0 +1,90 @@
+package cur
+
+// gapBuffer stores a sequence of items with a gap at the edit position, so that
+// consecutive edits around it take amortized constant time
+type gapBuffer[T any] struct {
+	buf   []T
+	start int
+	end   int
+}
+
+func newGapBuffer[T any](items []T) *gapBuffer[T] {
+	buf := make([]T, len(items), len(items)+minGap)
+	copy(buf, items)
+	buf = buf[:cap(buf)]
+	return &gapBuffer[T]{
+		buf:   buf,
+		start: len(items),
+		end:   len(buf),
+	}
+}
+
+const minGap = 16
+
+// len returns the number of items in the buffer
+func (g *gapBuffer[T]) len() int {
+	return len(g.buf) - (g.end - g.start)
+}
+
+// at returns the item in index `idx`, which must be within the bounds of the buffer
+func (g *gapBuffer[T]) at(idx int) T {
+	if idx < g.start {
+		return g.buf[idx]
+	}
+	return g.buf[idx+g.end-g.start]
+}
+
+// moveGap places the gap before index `idx`
+func (g *gapBuffer[T]) moveGap(idx int) {
+	switch {
+	case idx < g.start:
+		n := copy(g.buf[g.end-(g.start-idx):g.end], g.buf[idx:g.start])
+		clear(g.buf[idx:min(g.start, g.end-n)])
+		g.start, g.end = idx, g.end-n
+	case idx > g.start:
+		n := idx - g.start
+		copy(g.buf[g.start:], g.buf[g.end:g.end+n])
+		clear(g.buf[max(g.start+n, g.end) : g.end+n])
+		g.start, g.end = idx, g.end+n
+	}
+}
+
+// grow ensures the gap fits at least `n` items
+func (g *gapBuffer[T]) grow(n int) {
+	if g.end-g.start >= n {
+		return
+	}
+	size := max(2*len(g.buf), g.len()+n+minGap)
+	buf := make([]T, size)
+	copy(buf, g.buf[:g.start])
+	tail := len(g.buf) - g.end
+	copy(buf[size-tail:], g.buf[g.end:])
+	g.buf, g.end = buf, size-tail
+}
+
+// insert adds `items` before index `idx`
+func (g *gapBuffer[T]) insert(idx int, items []T) {
+	g.moveGap(idx)
+	g.grow(len(items))
+	g.start += copy(g.buf[g.start:], items)
+}
+
+// delete removes `n` items starting at index `idx`
+func (g *gapBuffer[T]) delete(idx, n int) {
+	g.moveGap(idx)
+	clear(g.buf[g.end : g.end+n])
+	g.end += n
+}
+
+// slice returns the items from index `start` to index `end`, moving the gap out of
+// the way if it splits them
+func (g *gapBuffer[T]) slice(start, end int) []T {
+	if start < g.start && end > g.start {
+		g.moveGap(end)
+	}
+	if end <= g.start {
+		return g.buf[start:end]
+	}
+	gap := g.end - g.start
+	return g.buf[start+gap : end+gap]
+}
@@ -0,0 +1,134 @@
+package cur
+
+import "slices"
+
+// edit is a reversible splice in an Editor, replacing the items `removed` in index `at`
+// with the items `inserted`
+type edit[T any] struct {
+	at       int
+	removed  []T
+	inserted []T
+	before   int
+	after    int
+	moved    []markMove
+}
+
+// history keeps the undoable and redoable groups of edits in an Editor
+type history[T any] struct {
+	undo  [][]edit[T]
+	redo  [][]edit[T]
+	group []edit[T]
+	depth int
+	limit int
+}
+
+// record adds the edit `e` to the open group, or as its own group if none is open
+func (h *history[T]) record(e edit[T]) {
+	if h.limit == 0 {
+		return
+	}
+	h.redo = nil
+	if h.depth > 0 {
+		h.group = append(h.group, e)
+		return
+	}
+	h.push([]edit[T]{e})
+}
+
+// push adds the group of edits `g` to the undo stack, dropping the oldest group if the
+// history is full
+func (h *history[T]) push(g []edit[T]) {
+	if len(g) == 0 {
+		return
+	}
+	h.undo = append(h.undo, g)
+	if h.limit > 0 && len(h.undo) > h.limit {
+		h.undo = slices.Delete(h.undo, 0, len(h.undo)-h.limit)
+	}
+}
+
+// flush closes any open group, pushing it to the undo stack
+func (h *history[T]) flush() {
+	h.depth = 0
+	h.push(h.group)
+	h.group = nil
+}
+
+// splice removes `n` items in index `at` and inserts `items` in their place, recording it
+// in the history and leaving the cursor in position `after`
+func (c *editor[T]) splice(at, n int, items []T, after int) {
+	e := edit[T]{
+		at:       at,
+		removed:  slices.Clone(c.buf.slice(at, at+n)),
+		inserted: slices.Clone(items),
+		before:   c.pos,
+		after:    after,
+	}
+	c.buf.delete(at, n)
+	c.buf.insert(at, items)
+	e.moved = c.marks.shift(at, n, len(items))
+	c.pos = after
+	c.hist.record(e)
+}
+
+// Undo reverts the last group of edits, returning the cursor to where it was before them.
+// It returns false if there is nothing to undo
+//
+// Any open group is closed before undoing
+func (c *editor[T]) Undo() bool {
+	c.hist.flush()
+	if len(c.hist.undo) == 0 {
+		return false
+	}
+	g := c.hist.undo[len(c.hist.undo)-1]
+	c.hist.undo = c.hist.undo[:len(c.hist.undo)-1]
+
+	for i := len(g) - 1; i >= 0; i-- {
+		c.buf.delete(g[i].at, len(g[i].inserted))
+		c.buf.insert(g[i].at, g[i].removed)
+		c.marks.unshift(g[i].at, len(g[i].removed), len(g[i].inserted), g[i].moved)
+		c.pos = g[i].before
+	}
+	c.hist.redo = append(c.hist.redo, g)
+	return true
+}
+
+// Redo reapplies the last group of undone edits, returning the cursor to where it was after
+// them. It returns false if there is nothing to redo
+//
+// Any open group is closed before redoing
+func (c *editor[T]) Redo() bool {
+	c.hist.flush()
+	if len(c.hist.redo) == 0 {
+		return false
+	}
+	g := c.hist.redo[len(c.hist.redo)-1]
+	c.hist.redo = c.hist.redo[:len(c.hist.redo)-1]
+
+	for _, e := range g {
+		c.buf.delete(e.at, len(e.removed))
+		c.buf.insert(e.at, e.inserted)
+		c.marks.shift(e.at, len(e.removed), len(e.inserted))
+		c.pos = e.after
+	}
+	c.hist.undo = append(c.hist.undo, g)
+	return true
+}
+
+// BeginGroup opens a group of edits, which are undone and redone as a single unit once
+// closed with EndGroup. Groups can be nested, in which case only the outermost group
+// is recorded as a unit
+func (c *editor[T]) BeginGroup() {
+	c.hist.depth++
+}
+
+// EndGroup closes the innermost group of edits opened with BeginGroup
+func (c *editor[T]) EndGroup() {
+	if c.hist.depth == 0 {
+		return
+	}
+	c.hist.depth--
+	if c.hist.depth == 0 {
+		c.hist.flush()
+	}
+}
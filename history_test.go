@@ -0,0 +1,110 @@
+package cur
+
+import "testing"
+
+func TestHistory(t *testing.T) {
+	t.Run("UndoRedo", func(t *testing.T) {
+		e := NewEditor([]rune("hello world"))
+		e.Idx(5)
+		e.Insert(',')
+		e.Tail()
+		e.Next()
+		e.Insert('!')
+		e.Idx(0)
+		e.Replace(1, 'H')
+
+		for _, want := range []string{"hello, world!", "hello, world", "hello world"} {
+			if !e.Undo() {
+				t.Fatalf("expected an edit to undo")
+			}
+			if got := string(e.Items()); got != want {
+				t.Errorf("unexpected output: wanted %q ; got %q", want, got)
+			}
+		}
+		if e.Undo() {
+			t.Errorf("expected no edits to undo")
+		}
+		if e.Pos() != 5 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 5, e.Pos())
+		}
+
+		for _, want := range []string{"hello, world", "hello, world!", "Hello, world!"} {
+			if !e.Redo() {
+				t.Fatalf("expected an edit to redo")
+			}
+			if got := string(e.Items()); got != want {
+				t.Errorf("unexpected output: wanted %q ; got %q", want, got)
+			}
+		}
+		if e.Redo() {
+			t.Errorf("expected no edits to redo")
+		}
+		if e.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, e.Pos())
+		}
+	})
+	t.Run("Backspace", func(t *testing.T) {
+		e := NewEditor([]rune("abcdef"))
+		e.Idx(4)
+		e.Backspace(2)
+		e.Delete(1)
+		e.Undo()
+		e.Undo()
+		if got := string(e.Items()); got != "abcdef" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "abcdef", got)
+		}
+		if e.Pos() != 4 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 4, e.Pos())
+		}
+	})
+	t.Run("Group", func(t *testing.T) {
+		e := NewEditor([]string{"a", "+", "b"})
+		e.Insert("x")
+		e.BeginGroup()
+		e.Idx(1)
+		e.Replace(1, "-")
+		e.BeginGroup()
+		e.Tail()
+		e.Next()
+		e.Insert(";")
+		e.EndGroup()
+		e.EndGroup()
+
+		if !e.Undo() {
+			t.Fatalf("expected an edit to undo")
+		}
+		if got := e.Items(); len(got) != 4 || got[2] != "+" {
+			t.Errorf("unexpected output: %v", got)
+		}
+		if e.Redo(); e.Len() != 5 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 5, e.Len())
+		}
+	})
+	t.Run("RedoCleared", func(t *testing.T) {
+		e := NewEditor([]int{1, 2, 3})
+		e.Delete(1)
+		e.Undo()
+		e.Insert(0)
+		if e.Redo() {
+			t.Errorf("expected no edits to redo")
+		}
+	})
+	t.Run("Limit", func(t *testing.T) {
+		e := NewEditor([]int{}, WithHistory(2))
+		for i := range 5 {
+			e.Insert(i)
+		}
+		if !e.Undo() || !e.Undo() || e.Undo() {
+			t.Errorf("expected exactly 2 edits to undo")
+		}
+		if e.Len() != 3 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 3, e.Len())
+		}
+
+		e = NewEditor([]int{}, WithHistory(0))
+		e.Insert(1)
+		if e.Undo() {
+			t.Errorf("expected no edits to undo")
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package cur
+
+import (
+	"iter"
+	"slices"
+)
+
+// FromSeq returns a Cursor for the items yielded by the sequence `seq`, or nil if
+// it yields no items
+//
+// The sequence is consumed and buffered before returning
+func FromSeq[T any](seq iter.Seq[T], opts ...Option) Cursor[T] {
+	return New(slices.Collect(seq), opts...)
+}
+
+func all[T any](c CheckedCursor[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if _, ok := c.IdxOK(0); !ok {
+			return
+		}
+		forward(c)(yield)
+	}
+}
+
+func forward[T any](c CheckedCursor[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		n := lap[T](c)
+		for ; n != 0; n-- {
+			idx := c.Pos()
+			v, ok := c.CurOK()
+			if !ok || !yield(idx, v) {
+				return
+			}
+			c.NextOK()
+		}
+	}
+}
+
+func backward[T any](c CheckedCursor[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		v, ok := c.CurOK()
+		if !ok {
+			// placed past the end of the slice; start from its last item
+			if v, ok = c.PrevOK(); !ok {
+				return
+			}
+		}
+		for n := lap[T](c); n != 0; n-- {
+			if !yield(c.Pos(), v) {
+				return
+			}
+			if v, ok = c.PrevOK(); !ok {
+				return
+			}
+		}
+	}
+}
+
+// lap returns the number of steps in one lap of the Cursor `c` if it wraps around the
+// slice, or -1 otherwise
+func lap[T any](c Cursor[T]) int {
+	if w, ok := c.(wrapper); ok && w.wraps() {
+		return c.Len()
+	}
+	return -1
+}
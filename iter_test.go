@@ -0,0 +1,125 @@
+package cur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIter(t *testing.T) {
+	data := []int{1, 11, 21, 31}
+
+	for _, test := range []struct {
+		name string
+		c    func() Cursor[int]
+	}{
+		{"New", func() Cursor[int] { return New(data) }},
+		{"Ptr", func() Cursor[int] { return Ptr(&data) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("All", func(t *testing.T) {
+				c := test.c()
+				c.Idx(2)
+				var got []int
+				for i, v := range c.All() {
+					if v != data[i] {
+						t.Errorf("unexpected value: wanted %d ; got %d", data[i], v)
+					}
+					got = append(got, v)
+				}
+				if !slices.Equal(got, data) {
+					t.Errorf("unexpected output: wanted %v ; got %v", data, got)
+				}
+				if c.Pos() != -1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+				}
+			})
+			t.Run("Forward", func(t *testing.T) {
+				c := test.c()
+				c.Idx(1)
+				var got []int
+				for i, v := range c.Forward() {
+					got = append(got, i)
+					if v == data[2] {
+						break
+					}
+				}
+				if !slices.Equal(got, []int{1, 2}) {
+					t.Errorf("unexpected output: wanted %v ; got %v", []int{1, 2}, got)
+				}
+				if c.Cur() != data[2] {
+					t.Errorf("unexpected value: wanted %d ; got %d", data[2], c.Cur())
+				}
+			})
+			t.Run("Backward", func(t *testing.T) {
+				c := test.c()
+				c.Tail()
+				c.Next()
+				var got []int
+				for i := range c.Backward() {
+					got = append(got, i)
+				}
+				if !slices.Equal(got, []int{3, 2, 1, 0}) {
+					t.Errorf("unexpected output: wanted %v ; got %v", []int{3, 2, 1, 0}, got)
+				}
+				if c.Pos() != 0 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+				}
+			})
+		})
+	}
+
+	t.Run("SyncRing", func(t *testing.T) {
+		t.Run("All", func(t *testing.T) {
+			c := Sync(Ring(data))
+			c.Idx(2)
+			var got []int
+			for _, v := range c.All() {
+				got = append(got, v)
+			}
+			if !slices.Equal(got, data) {
+				t.Errorf("unexpected output: wanted %v ; got %v", data, got)
+			}
+			if c.Pos() != 0 {
+				t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+			}
+		})
+		t.Run("Forward", func(t *testing.T) {
+			c := Sync(Ring(data))
+			c.Idx(1)
+			var got []int
+			for i := range c.Forward() {
+				got = append(got, i)
+			}
+			if want := []int{1, 2, 3, 0}; !slices.Equal(got, want) {
+				t.Errorf("unexpected output: wanted %v ; got %v", want, got)
+			}
+			if c.Pos() != 1 {
+				t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+			}
+		})
+		t.Run("Backward", func(t *testing.T) {
+			c := Sync(Ring(data))
+			c.Idx(1)
+			var got []int
+			for i := range c.Backward() {
+				got = append(got, i)
+			}
+			if want := []int{1, 0, 3, 2}; !slices.Equal(got, want) {
+				t.Errorf("unexpected output: wanted %v ; got %v", want, got)
+			}
+			if c.Pos() != 1 {
+				t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+			}
+		})
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		c := FromSeq(slices.Values(data))
+		if c.Len() != len(data) {
+			t.Errorf("unexpected length: wanted %d ; got %d", len(data), c.Len())
+		}
+		if FromSeq(slices.Values([]int{})) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+}
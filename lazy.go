@@ -0,0 +1,65 @@
+package cur
+
+import (
+	"io"
+	"iter"
+)
+
+// LazyCursor is a Cursor over a generated sequence of items, which are only materialized
+// as the cursor advances or peeks ahead, and then memoized so that they can be visited
+// again
+//
+// Its Len method returns the number of items materialized so far
+type LazyCursor[T any] interface {
+	CheckedCursor[T]
+
+	// Exhausted returns whether the source has no more items to generate, in which case
+	// Len returns the total number of items. It may materialize the next item to find out
+	Exhausted() bool
+
+	// Close stops generating items, releasing the source. Items already materialized
+	// remain accessible
+	Close()
+}
+
+// Lazy returns a LazyCursor that generates items with the function `next`, until it
+// returns false; or nil if `next` is nil
+func Lazy[T any](next func() (T, bool)) LazyCursor[T] {
+	if next == nil {
+		return nil
+	}
+	return newStreamCursor(func() (T, error) {
+		v, ok := next()
+		if !ok {
+			return v, io.EOF
+		}
+		return v, nil
+	}, 0)
+}
+
+// LazySeq returns a LazyCursor that generates items by pulling them from the sequence
+// `seq`, or nil if `seq` is nil
+//
+// The sequence is released once exhausted or when the cursor is closed
+func LazySeq[T any](seq iter.Seq[T]) LazyCursor[T] {
+	if seq == nil {
+		return nil
+	}
+	next, stop := iter.Pull(seq)
+	c := Lazy(next).(*streamCursor[T])
+	c.src.stop = stop
+	return c
+}
+
+// Exhausted returns whether the source has no more items to generate, in which case
+// Len returns the total number of items. It may materialize the next item to find out
+func (c *streamCursor[T]) Exhausted() bool {
+	c.src.keep = c.pos
+	return !c.src.fill(c.src.len())
+}
+
+// Close stops generating items, releasing the source. Items already materialized
+// remain accessible
+func (c *streamCursor[T]) Close() {
+	c.src.close()
+}
@@ -0,0 +1,85 @@
+package cur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		if Lazy[int](nil) != nil || LazySeq[int](nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("Func", func(t *testing.T) {
+		var calls int
+		c := Lazy(func() (int, bool) {
+			if calls == 5 {
+				return 0, false
+			}
+			calls++
+			return calls * 10, true
+		})
+
+		if v := c.Next(); v != 10 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 10, v)
+		}
+		if v := c.Peek(); v != 30 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 30, v)
+		}
+		if calls != 3 || c.Len() != 3 {
+			t.Errorf("unexpected materialized items: wanted %d ; got %d (length %d)", 3, calls, c.Len())
+		}
+		if c.Exhausted() {
+			t.Errorf("expected cursor not to be exhausted")
+		}
+		if v := c.Idx(4); v != 50 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 50, v)
+		}
+		if v := c.Idx(0); v != 10 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 10, v)
+		}
+		if got := c.Extract(1, 4); !slices.Equal(got, []int{20, 30, 40}) {
+			t.Errorf("unexpected output: wanted %v ; got %v", []int{20, 30, 40}, got)
+		}
+		if !c.Exhausted() {
+			t.Errorf("expected cursor to be exhausted")
+		}
+		if c.Len() != 5 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 5, c.Len())
+		}
+		if _, ok := c.IdxOK(5); ok {
+			t.Errorf("expected EOF")
+		}
+	})
+	t.Run("Seq", func(t *testing.T) {
+		var yielded int
+		c := LazySeq(func(yield func(int) bool) {
+			for i := range 100 {
+				yielded++
+				if !yield(i) {
+					return
+				}
+			}
+		})
+
+		c.Offset(3)
+		if v := c.Prev(); v != 2 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 2, v)
+		}
+		if yielded != 4 {
+			t.Errorf("unexpected yielded items: wanted %d ; got %d", 4, yielded)
+		}
+
+		c.Close()
+		if !c.Exhausted() {
+			t.Errorf("expected cursor to be exhausted")
+		}
+		if v := c.Head(); v != 0 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 0, v)
+		}
+		if _, ok := c.IdxOK(10); ok {
+			t.Errorf("expected EOF")
+		}
+	})
+}
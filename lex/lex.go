@@ -0,0 +1,199 @@
+// Package lex implements a state function lexer over a cur.Cursor of runes or bytes
+//
+// Each state function consumes items from the cursor, emits the tokens it recognizes,
+// and returns the next state function, until one of them returns nil
+package lex
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/zalgonoise/cur"
+)
+
+// Item is the type of the items a Lexer can scan
+type Item interface {
+	rune | byte
+}
+
+// Kind identifies the type of a Token. Kinds defined by callers should be positive
+type Kind int
+
+const (
+	// Error is the Kind of the tokens emitted by Errorf, holding the error message
+	Error Kind = -1
+	// EOF is the Kind of the token marking the end of the input
+	EOF Kind = 0
+)
+
+// Span is the range of indices [Start, End) of the items in the cursor that make up
+// a Token
+type Span struct {
+	Start int
+	End   int
+}
+
+// Token is a lexeme emitted by a Lexer
+type Token struct {
+	Kind  Kind
+	Value string
+	Span  Span
+}
+
+// StateFn is a state of the Lexer, returning the next state, or nil to stop lexing
+type StateFn[T Item] func(*Lexer[T]) StateFn[T]
+
+// Lexer scans the items in a cursor, tracking the start of the token being scanned
+type Lexer[T Item] struct {
+	c      cur.CheckedCursor[T]
+	state  StateFn[T]
+	start  int
+	backup bool
+	tokens []Token
+}
+
+// New returns a Lexer for the cursor `c`, starting in the state `start`, or nil if either
+// of them is nil
+func New[T Item](c cur.Cursor[T], start StateFn[T]) *Lexer[T] {
+	if c == nil || start == nil {
+		return nil
+	}
+	return &Lexer[T]{
+		c:     cur.Check(c),
+		state: start,
+		start: int(c.Mark()),
+	}
+}
+
+// Cursor returns the cursor the Lexer scans, to use with the helpers in the cur package
+// like cur.TakeWhile or cur.AcceptRun
+func (l *Lexer[T]) Cursor() cur.Cursor[T] {
+	return l.c
+}
+
+// Next consumes the next item, returning it and whether the end of the input was not
+// yet reached
+func (l *Lexer[T]) Next() (T, bool) {
+	v, ok := l.c.NextOK()
+	l.backup = ok
+	return v, ok
+}
+
+// Peek returns the next item without consuming it, and whether the end of the input was
+// not yet reached
+func (l *Lexer[T]) Peek() (T, bool) {
+	return l.c.CurOK()
+}
+
+// Backup steps back over the item consumed by the last call to Next. It can only be
+// called once per call to Next, and has no effect if Next reached the end of the input
+func (l *Lexer[T]) Backup() {
+	if l.backup {
+		l.c.PrevOK()
+		l.backup = false
+	}
+}
+
+// Ignore skips over the items consumed since the start of the current token
+func (l *Lexer[T]) Ignore() {
+	l.start = l.pos()
+}
+
+// Start returns the index of the first item in the current token
+func (l *Lexer[T]) Start() int {
+	return l.start
+}
+
+// Pos returns the index of the next item to be consumed
+func (l *Lexer[T]) Pos() int {
+	return l.pos()
+}
+
+// Value returns the items consumed since the start of the current token
+func (l *Lexer[T]) Value() string {
+	return text(l.c.Extract(l.start, l.pos()))
+}
+
+// Emit emits a token of kind `k` with the items consumed since the start of the current
+// token, starting a new one
+func (l *Lexer[T]) Emit(k Kind) {
+	pos := l.pos()
+	l.tokens = append(l.tokens, Token{
+		Kind:  k,
+		Value: l.Value(),
+		Span:  Span{Start: l.start, End: pos},
+	})
+	l.start = pos
+}
+
+// Errorf emits an Error token holding the formatted message, spanning the items consumed
+// since the start of the current token, and returns nil to stop lexing
+func (l *Lexer[T]) Errorf(format string, args ...any) StateFn[T] {
+	l.tokens = append(l.tokens, Token{
+		Kind:  Error,
+		Value: fmt.Sprintf(format, args...),
+		Span:  Span{Start: l.start, End: l.pos()},
+	})
+	return nil
+}
+
+// NextToken runs the state functions until a token is emitted, returning it and
+// whether there was one before the lexer stopped
+func (l *Lexer[T]) NextToken() (Token, bool) {
+	for len(l.tokens) == 0 {
+		if l.state == nil {
+			return Token{}, false
+		}
+		l.state = l.state(l)
+	}
+
+	t := l.tokens[0]
+	l.tokens = l.tokens[1:]
+	return t, true
+}
+
+// Tokens returns an iterator over the tokens emitted by the Lexer, running the state
+// functions as the tokens are consumed
+func (l *Lexer[T]) Tokens() iter.Seq[Token] {
+	return func(yield func(Token) bool) {
+		for {
+			t, ok := l.NextToken()
+			if !ok || !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Chan runs the Lexer in a goroutine, sending the tokens it emits to the returned
+// channel, which is closed when the lexer stops or the context `ctx` is done
+func (l *Lexer[T]) Chan(ctx context.Context) <-chan Token {
+	ch := make(chan Token)
+	go func() {
+		defer close(ch)
+		for t := range l.Tokens() {
+			select {
+			case ch <- t:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func (l *Lexer[T]) pos() int {
+	return int(l.c.Mark())
+}
+
+// text converts the items in `v` into a string
+func text[T Item](v []T) string {
+	switch v := any(v).(type) {
+	case []rune:
+		return string(v)
+	case []byte:
+		return string(v)
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package lex
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/zalgonoise/cur"
+)
+
+const (
+	Number Kind = iota + 1
+	Ident
+	Op
+)
+
+func lexAny[T Item](l *Lexer[T]) StateFn[T] {
+	cur.SkipWhile(l.Cursor(), func(v T) bool { return unicode.IsSpace(rune(v)) })
+	l.Ignore()
+
+	v, ok := l.Next()
+	switch {
+	case !ok:
+		l.Emit(EOF)
+		return nil
+	case unicode.IsDigit(rune(v)):
+		l.Backup()
+		return lexNumber[T]
+	case unicode.IsLetter(rune(v)):
+		cur.TakeWhile(l.Cursor(), func(v T) bool { return unicode.IsLetter(rune(v)) })
+		l.Emit(Ident)
+		return lexAny[T]
+	case strings.ContainsRune("+-*/", rune(v)):
+		l.Emit(Op)
+		return lexAny[T]
+	}
+	return l.Errorf("unexpected character %q", rune(v))
+}
+
+func lexNumber[T Item](l *Lexer[T]) StateFn[T] {
+	for {
+		v, ok := l.Next()
+		if !ok {
+			break
+		}
+		if !unicode.IsDigit(rune(v)) {
+			l.Backup()
+			break
+		}
+	}
+	l.Emit(Number)
+	return lexAny[T]
+}
+
+func TestLexer(t *testing.T) {
+	wants := []Token{
+		{Ident, "x", Span{0, 1}},
+		{Op, "+", Span{2, 3}},
+		{Number, "42", Span{4, 6}},
+		{Op, "*", Span{6, 7}},
+		{Ident, "yé", Span{8, 10}},
+		{EOF, "", Span{10, 10}},
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		if New[rune](nil, lexAny[rune]) != nil || New(cur.Runes("x"), nil) != nil {
+			t.Errorf("expected lexer to be nil")
+		}
+	})
+	t.Run("Tokens", func(t *testing.T) {
+		var i int
+		for tok := range New(cur.Runes("x + 42* yé"), lexAny[rune]).Tokens() {
+			if i >= len(wants) {
+				t.Fatalf("unexpected token: %+v", tok)
+			}
+			if tok != wants[i] {
+				t.Errorf("unexpected token: wanted %+v ; got %+v", wants[i], tok)
+			}
+			i++
+		}
+		if i != len(wants) {
+			t.Errorf("unexpected token count: wanted %d ; got %d", len(wants), i)
+		}
+	})
+	t.Run("Bytes", func(t *testing.T) {
+		l := New(cur.New([]byte("12 - ab")), lexAny[byte])
+		for _, want := range []Token{
+			{Number, "12", Span{0, 2}},
+			{Op, "-", Span{3, 4}},
+			{Ident, "ab", Span{5, 7}},
+			{EOF, "", Span{7, 7}},
+		} {
+			tok, ok := l.NextToken()
+			if !ok || tok != want {
+				t.Errorf("unexpected token: wanted %+v ; got %+v (%v)", want, tok, ok)
+			}
+		}
+		if _, ok := l.NextToken(); ok {
+			t.Errorf("expected the lexer to stop")
+		}
+	})
+	t.Run("Errorf", func(t *testing.T) {
+		l := New(cur.Runes("1 ? 2"), lexAny[rune])
+		l.NextToken()
+		tok, _ := l.NextToken()
+		want := Token{Error, `unexpected character '?'`, Span{2, 3}}
+		if tok != want {
+			t.Errorf("unexpected token: wanted %+v ; got %+v", want, tok)
+		}
+		if _, ok := l.NextToken(); ok {
+			t.Errorf("expected the lexer to stop")
+		}
+	})
+	t.Run("Backup", func(t *testing.T) {
+		l := New(cur.Runes("ab"), lexAny[rune])
+		l.Next()
+		l.Backup()
+		l.Backup()
+		if l.Pos() != 0 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 0, l.Pos())
+		}
+		l.Next()
+		l.Next()
+		if _, ok := l.Next(); ok {
+			t.Errorf("expected EOF")
+		}
+		l.Backup()
+		if l.Pos() != 2 || l.Value() != "ab" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "ab", l.Value())
+		}
+	})
+	t.Run("Chan", func(t *testing.T) {
+		var i int
+		for tok := range New(cur.Runes("x + 42* yé"), lexAny[rune]).Chan(context.Background()) {
+			if tok != wants[i] {
+				t.Errorf("unexpected token: wanted %+v ; got %+v", wants[i], tok)
+			}
+			i++
+		}
+		if i != len(wants) {
+			t.Errorf("unexpected token count: wanted %d ; got %d", len(wants), i)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := New(cur.Runes("x + 42* yé"), lexAny[rune]).Chan(ctx)
+		<-ch
+		cancel()
+		for range ch {
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package cur
+
+// Mark is a snapshot of a Cursor's position, as returned by its Mark method
+//
+// Unlike the value returned by Pos, a Mark also captures the position past the end
+// of the slice, so it is always restored by the cursor's Reset method
+type Mark int
+
+// txStack holds the marks of a cursor's open transactions
+type txStack []Mark
+
+func (s *txStack) push(m Mark) {
+	*s = append(*s, m)
+}
+
+func (s *txStack) pop() (Mark, error) {
+	if len(*s) == 0 {
+		return 0, ErrNoTransaction
+	}
+	m := (*s)[len(*s)-1]
+	*s = (*s)[:len(*s)-1]
+	return m, nil
+}
+
+// clamp limits the position `pos` to the range [0, length]
+func clamp(pos, length int) int {
+	if pos < 0 {
+		return 0
+	}
+	if length >= 0 && pos > length {
+		return length
+	}
+	return pos
+}
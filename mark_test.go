@@ -0,0 +1,82 @@
+package cur
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMark(t *testing.T) {
+	data := []int{1, 11, 21}
+
+	for _, test := range []struct {
+		name string
+		c    func() Cursor[int]
+	}{
+		{"New", func() Cursor[int] { return New(data) }},
+		{"Ptr", func() Cursor[int] { return Ptr(&data) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Reset", func(t *testing.T) {
+				c := test.c()
+				c.Next()
+				m := c.Mark()
+				c.Tail()
+				c.Reset(m)
+				if v := c.Cur(); v != data[1] {
+					t.Errorf("unexpected value: wanted %d ; got %d", data[1], v)
+				}
+			})
+			t.Run("ResetEOF", func(t *testing.T) {
+				c := test.c()
+				for range data {
+					c.Next()
+				}
+				if c.Pos() != -1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+				}
+				m := c.Mark()
+				c.Head()
+				c.Reset(m)
+				if c.Pos() != -1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+				}
+				if v := c.Prev(); v != data[2] {
+					t.Errorf("unexpected value: wanted %d ; got %d", data[2], v)
+				}
+			})
+			t.Run("Transaction", func(t *testing.T) {
+				c := test.c()
+				c.Begin()
+				c.Next()
+				c.Begin()
+				c.Next()
+				if err := c.Rollback(); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if c.Pos() != 1 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+				}
+				c.Begin()
+				c.Next()
+				if err := c.Commit(); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if c.Pos() != 2 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+				}
+				if err := c.Rollback(); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if c.Pos() != 0 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+				}
+				if err := c.Commit(); !errors.Is(err, ErrNoTransaction) {
+					t.Errorf("unexpected error: wanted %v ; got %v", ErrNoTransaction, err)
+				}
+				if err := c.Rollback(); !errors.Is(err, ErrNoTransaction) {
+					t.Errorf("unexpected error: wanted %v ; got %v", ErrNoTransaction, err)
+				}
+			})
+		})
+	}
+}
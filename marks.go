@@ -0,0 +1,181 @@
+package cur
+
+import "slices"
+
+// Marker is implemented by cursors that keep named marks, which shift as items are
+// inserted or removed before them through the cursor, like the Editor and Ptr cursors
+//
+// Marks are shared with the cursor's clones
+type Marker[T any] interface {
+	// SetMark places the mark `name` in the current position, replacing any existing
+	// mark with the same name
+	SetMark(name string)
+
+	// JumpMark moves the cursor to the mark `name`, returning the item in that position
+	// and whether the mark exists
+	JumpMark(name string) (T, bool)
+
+	// ExtractFromMark returns the items between the mark `name` and the current position,
+	// or nil if the mark doesn't exist
+	ExtractFromMark(name string) []T
+
+	// DeleteMark removes the mark `name`
+	DeleteMark(name string)
+}
+
+// PtrCursor is the Cursor returned by Ptr, which can also edit the slice it points to
+// while keeping its position and marks on the same items
+type PtrCursor[T any] interface {
+	CheckedCursor[T]
+	Marker[T]
+
+	// Splice replaces the `n` items in index `at` of the underlying slice with `items`.
+	// The cursor's position and marks after the replaced items are shifted accordingly,
+	// while those within them are moved to index `at`
+	Splice(at, n int, items ...T)
+
+	// Changed reports whether the slice was changed by something other than the cursor,
+	// either resized or replaced with a different one, since the last call to Changed.
+	// It also clears any *ResizeError recorded under the ErrorOnResize policy
+	//
+	// Edits made with Splice are not reported as changes
+	Changed() bool
+}
+
+// marks holds named positions in a cursor
+type marks map[string]int
+
+// markMove records a mark moved by an edit, so that it can be restored when undoing it
+type markMove struct {
+	name   string
+	before int
+	after  int
+}
+
+// shift updates the marks after replacing `removed` items in index `at` with `inserted`
+// items, returning the marks that were moved. Marks within the removed items collapse
+// to `at`, while a mark in `at` stays in place when items are inserted there
+func (m marks) shift(at, removed, inserted int) (moved []markMove) {
+	for name, pos := range m {
+		next := pos
+		switch {
+		case pos <= at:
+			continue
+		case pos < at+removed:
+			next = at
+		default:
+			next = pos + inserted - removed
+		}
+		if next != pos {
+			m[name] = next
+			moved = append(moved, markMove{name: name, before: pos, after: next})
+		}
+	}
+	return moved
+}
+
+// unshift reverts the edit replacing `removed` items in index `at` with `inserted` items,
+// which moved the marks in `moved`. Those are returned to their previous positions unless
+// they were moved since, while the remaining marks are shifted back
+func (m marks) unshift(at, removed, inserted int, moved []markMove) {
+	restore := make([]markMove, 0, len(moved))
+	for _, mv := range moved {
+		if pos, ok := m[mv.name]; ok && pos == mv.after {
+			restore = append(restore, mv)
+		}
+	}
+
+	m.shift(at, inserted, removed)
+	for _, mv := range restore {
+		m[mv.name] = mv.before
+	}
+}
+
+// SetMark places the mark `name` in the current position, replacing any existing
+// mark with the same name
+func (c *editor[T]) SetMark(name string) {
+	c.marks[name] = c.position()
+}
+
+// JumpMark moves the cursor to the mark `name`, returning the item in that position
+// and whether the mark exists
+func (c *editor[T]) JumpMark(name string) (T, bool) {
+	pos, ok := c.marks[name]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	c.Reset(Mark(pos))
+	return c.Cur(), true
+}
+
+// ExtractFromMark returns the items between the mark `name` and the current position,
+// or nil if the mark doesn't exist
+func (c *editor[T]) ExtractFromMark(name string) []T {
+	pos, ok := c.marks[name]
+	if !ok {
+		return nil
+	}
+	p := c.position()
+	return c.Extract(min(pos, p), max(pos, p))
+}
+
+// DeleteMark removes the mark `name`
+func (c *editor[T]) DeleteMark(name string) {
+	delete(c.marks, name)
+}
+
+// SetMark places the mark `name` in the current position, replacing any existing
+// mark with the same name
+func (c *ptrCursor[T]) SetMark(name string) {
+	c.marks[name] = c.pos
+}
+
+// JumpMark moves the cursor to the mark `name`, returning the item in that position
+// and whether the mark exists
+func (c *ptrCursor[T]) JumpMark(name string) (T, bool) {
+	pos, ok := c.marks[name]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	c.Reset(Mark(pos))
+	return c.Cur(), true
+}
+
+// ExtractFromMark returns the items between the mark `name` and the current position,
+// or nil if the mark doesn't exist
+func (c *ptrCursor[T]) ExtractFromMark(name string) []T {
+	pos, ok := c.marks[name]
+	if !ok {
+		return nil
+	}
+	return c.Extract(min(pos, c.pos), max(pos, c.pos))
+}
+
+// DeleteMark removes the mark `name`
+func (c *ptrCursor[T]) DeleteMark(name string) {
+	delete(c.marks, name)
+}
+
+// Splice replaces the `n` items in index `at` of the underlying slice with `items`.
+// The cursor's position and marks after the replaced items are shifted accordingly,
+// while those within them are moved to index `at`
+func (c *ptrCursor[T]) Splice(at, n int, items ...T) {
+	if c.slice == nil {
+		return
+	}
+	c.sync()
+	at = clamp(at, len(*c.slice))
+	n = clamp(n, len(*c.slice)-at)
+	*c.slice = slices.Replace(*c.slice, at, at+n, items...)
+	c.seen = snap(*c.slice)
+
+	switch {
+	case c.pos >= at+n:
+		c.pos += len(items) - n
+	case c.pos > at:
+		c.pos = at
+	}
+	c.marks.shift(at, n, len(items))
+}
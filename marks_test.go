@@ -0,0 +1,86 @@
+package cur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMarks(t *testing.T) {
+	t.Run("Editor", func(t *testing.T) {
+		e := NewEditor([]rune("let x = 1;"))
+		e.Idx(4)
+		e.SetMark("ident")
+		e.Idx(8)
+		e.SetMark("value")
+
+		e.Idx(0)
+		e.Insert([]rune("const ")...)
+		if got := string(e.ExtractFromMark("ident")); got != "let " {
+			t.Errorf("unexpected output: wanted %q ; got %q", "let ", got)
+		}
+		if v, ok := e.JumpMark("value"); !ok || v != '1' {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", '1', v, ok)
+		}
+
+		e.Insert([]rune("10")...)
+		if v, ok := e.JumpMark("value"); !ok || v != '1' || e.Pos() != 14 {
+			t.Errorf("expected mark to stay at the insertion point ; got %q at %d", v, e.Pos())
+		}
+		e.Delete(2)
+		if got := string(e.ExtractFromMark("ident")); got != "x = " {
+			t.Errorf("unexpected output: wanted %q ; got %q", "x = ", got)
+		}
+
+		e.Idx(9)
+		e.Delete(5)
+		if _, ok := e.JumpMark("value"); !ok || e.Pos() != 9 {
+			t.Errorf("expected mark to collapse into the deletion point ; got %d", e.Pos())
+		}
+
+		e.Undo()
+		if _, ok := e.JumpMark("value"); !ok || e.Pos() != 14 {
+			t.Errorf("expected mark to shift back on undo ; got %d", e.Pos())
+		}
+		if _, ok := e.JumpMark("ident"); !ok || e.Pos() != 10 {
+			t.Errorf("expected collapsed mark to be restored on undo ; got %d", e.Pos())
+		}
+
+		e.DeleteMark("value")
+		if _, ok := e.JumpMark("value"); ok {
+			t.Errorf("expected mark to be deleted")
+		}
+		if e.ExtractFromMark("value") != nil {
+			t.Errorf("expected no items for a deleted mark")
+		}
+	})
+	t.Run("Ptr", func(t *testing.T) {
+		data := []string{"a", "=", "b", ";", "c", "=", "d", ";"}
+		c := Ptr(&data)
+		c.Idx(4)
+		c.SetMark("stmt")
+		c.Idx(6)
+
+		c.Splice(0, 4)
+		if len(data) != 4 || c.Cur() != "d" {
+			t.Errorf("unexpected state: %v at %q", data, c.Cur())
+		}
+		if got := c.ExtractFromMark("stmt"); !slices.Equal(got, []string{"c", "="}) {
+			t.Errorf("unexpected output: wanted %v ; got %v", []string{"c", "="}, got)
+		}
+
+		c.Splice(2, 0, "(", "e", ")")
+		if v := c.Cur(); v != "d" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "d", v)
+		}
+		if v, ok := c.JumpMark("stmt"); !ok || v != "c" {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", "c", v, ok)
+		}
+
+		clone := c.Clone().(PtrCursor[string])
+		clone.Idx(1)
+		clone.SetMark("eq")
+		if v, ok := c.JumpMark("eq"); !ok || v != "=" {
+			t.Errorf("expected marks to be shared with clones")
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package cur
+
+// HasPrefix returns whether the items in the Cursor `c`, starting from its current
+// position, begin with `seq`, without moving it
+//
+// On cursors that wrap around, like Ring, the sequence is matched across the end of the
+// slice, as when calling Next
+func HasPrefix[T comparable](c Cursor[T], seq []T) bool {
+	return HasPrefixFunc(c, seq, eq[T])
+}
+
+// HasPrefixFunc is like HasPrefix but compares the items with the function `eq`
+func HasPrefixFunc[T any](c Cursor[T], seq []T, eq func(a, b T) bool) bool {
+	cc := Check(c)
+	for i := range seq {
+		v, ok := cc.PeekOffsetOK(i)
+		if !ok || !eq(v, seq[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Consume consumes the items in `seq` from the current position of the Cursor `c` if it
+// begins with them, returning whether they were consumed
+func Consume[T comparable](c Cursor[T], seq []T) bool {
+	return ConsumeFunc(c, seq, eq[T])
+}
+
+// ConsumeFunc is like Consume but compares the items with the function `eq`
+func ConsumeFunc[T any](c Cursor[T], seq []T, eq func(a, b T) bool) bool {
+	if !HasPrefixFunc(c, seq, eq) {
+		return false
+	}
+	c.Reset(c.Mark() + Mark(len(seq)))
+	return true
+}
+
+// MatchAny consumes the longest of `seqs` that the Cursor `c` begins with, from its
+// current position, returning its index in `seqs` and whether any of them matched
+//
+// If several sequences of the same length match, the first one is consumed
+func MatchAny[T comparable](c Cursor[T], seqs ...[]T) (int, bool) {
+	return MatchAnyFunc(c, eq[T], seqs...)
+}
+
+// MatchAnyFunc is like MatchAny but compares the items with the function `eq`
+func MatchAnyFunc[T any](c Cursor[T], eq func(a, b T) bool, seqs ...[]T) (int, bool) {
+	match := -1
+	for i, seq := range seqs {
+		if (match < 0 || len(seq) > len(seqs[match])) && HasPrefixFunc(c, seq, eq) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return -1, false
+	}
+	c.Reset(c.Mark() + Mark(len(seqs[match])))
+	return match, true
+}
+
+func eq[T comparable](a, b T) bool {
+	return a == b
+}
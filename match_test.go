@@ -0,0 +1,108 @@
+package cur
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	src := []rune("a <<= b !== c")
+	ops := [][]rune{[]rune("<"), []rune("<<"), []rune("<<="), []rune("!="), []rune("!==")}
+
+	t.Run("HasPrefix", func(t *testing.T) {
+		c := New(src)
+		c.Idx(2)
+		if !HasPrefix(c, []rune("<<=")) {
+			t.Errorf("expected a prefix match")
+		}
+		if HasPrefix(c, []rune("<<=>")) {
+			t.Errorf("expected no prefix match")
+		}
+		if !HasPrefix(c, nil) {
+			t.Errorf("expected an empty sequence to match")
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+
+		c.Idx(12)
+		if HasPrefix(c, []rune("c ")) {
+			t.Errorf("expected no prefix match past the end")
+		}
+	})
+	t.Run("Consume", func(t *testing.T) {
+		c := New(src)
+		if Consume(c, []rune("a<")) {
+			t.Errorf("expected no match")
+		}
+		if !Consume(c, []rune("a ")) {
+			t.Errorf("expected a match")
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+
+		c.Idx(12)
+		if !Consume(c, []rune("c")) || c.Pos() != -1 {
+			t.Errorf("expected to consume the last item")
+		}
+	})
+	t.Run("MatchAny", func(t *testing.T) {
+		c := New(src)
+		if _, ok := MatchAny(c, ops...); ok {
+			t.Errorf("expected no match")
+		}
+
+		c.Idx(2)
+		if i, ok := MatchAny(c, ops...); !ok || i != 2 {
+			t.Errorf("unexpected match: wanted %d ; got %d (%v)", 2, i, ok)
+		}
+		if c.Pos() != 5 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 5, c.Pos())
+		}
+
+		c.Idx(8)
+		if i, ok := MatchAny(c, ops...); !ok || i != 4 {
+			t.Errorf("unexpected match: wanted %d ; got %d (%v)", 4, i, ok)
+		}
+	})
+	t.Run("Func", func(t *testing.T) {
+		c := New(strings.Fields("SELECT * FROM t"))
+		fold := strings.EqualFold
+		if !HasPrefixFunc(c, []string{"select", "*"}, fold) {
+			t.Errorf("expected a prefix match")
+		}
+		i, ok := MatchAnyFunc(c, fold, []string{"select"}, []string{"select", "*", "from"})
+		if !ok || i != 1 {
+			t.Errorf("unexpected match: wanted %d ; got %d (%v)", 1, i, ok)
+		}
+		if !ConsumeFunc(c, []string{"T"}, fold) || c.Pos() != -1 {
+			t.Errorf("expected to consume the last item")
+		}
+	})
+	t.Run("Stream", func(t *testing.T) {
+		c := RuneReader(strings.NewReader("!== x"), 4)
+		if i, ok := MatchAny(c, ops...); !ok || i != 4 {
+			t.Errorf("unexpected match: wanted %d ; got %d (%v)", 4, i, ok)
+		}
+		if v := c.Next(); v != ' ' {
+			t.Errorf("unexpected value: wanted %q ; got %q", ' ', v)
+		}
+	})
+	t.Run("Ring", func(t *testing.T) {
+		c := Ring([]rune("abc"))
+		c.Idx(2)
+		if !HasPrefix(c, []rune("cab")) {
+			t.Errorf("expected a prefix match across the end of the slice")
+		}
+		if HasPrefix(c, []rune("cb")) {
+			t.Errorf("expected no prefix match")
+		}
+		if !Consume(c, []rune("ca")) {
+			t.Errorf("expected to consume the sequence")
+		}
+		if v := c.Cur(); v != 'b' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'b', v)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package cur
+
+// Option configures a Cursor on creation
+type Option func(*config)
+
+type config struct {
+	strict       bool
+	prefetch     bool
+	history      int
+	limitHistory bool
+	resize       ResizePolicy
+	onResize     func(oldLen, newLen int)
+}
+
+func newConfig(opts ...Option) config {
+	var cfg config
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+// WithStrict makes the cursor record a *BoundsError whenever a navigation call falls
+// out of the bounds of the slice, which can be retrieved with Err
+func WithStrict() Option {
+	return func(cfg *config) {
+		cfg.strict = true
+	}
+}
+
+// WithPrefetch makes a Paged cursor fetch the next page in the background as soon as
+// the current one is loaded
+func WithPrefetch() Option {
+	return func(cfg *config) {
+		cfg.prefetch = true
+	}
+}
+
+// WithHistory limits the undo history of an Editor to the last `size` groups of edits,
+// disabling it if `size` is zero
+func WithHistory(size int) Option {
+	return func(cfg *config) {
+		cfg.history = max(size, 0)
+		cfg.limitHistory = true
+	}
+}
+
+// WithResizePolicy sets how a Ptr cursor repairs its position when the slice it points
+// to shrinks below it or is replaced by something other than the cursor
+func WithResizePolicy(policy ResizePolicy) Option {
+	return func(cfg *config) {
+		cfg.resize = policy
+	}
+}
+
+// WithResizeHook registers a function that a Ptr cursor calls with the previous and
+// current lengths of the slice it points to, whenever it detects that it was changed by
+// something other than the cursor
+//
+// Changes are detected on the cursor's next access to the slice, after applying the
+// resize policy
+func WithResizeHook(fn func(oldLen, newLen int)) Option {
+	return func(cfg *config) {
+		cfg.onResize = fn
+	}
+}
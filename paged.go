@@ -0,0 +1,105 @@
+package cur
+
+import (
+	"context"
+	"io"
+)
+
+// PageFunc fetches the page of items identified by `pageToken`, returning them along
+// with the token for the next page, which is empty for the last page
+//
+// The first page is fetched with an empty `pageToken`
+type PageFunc[T any] func(ctx context.Context, pageToken string) (items []T, next string, err error)
+
+// Paged returns a LazyCursor over the items in the pages returned by `fetch`, or nil if
+// `fetch` is nil
+//
+// Pages are fetched as the cursor advances or peeks across page boundaries, and the items
+// in visited pages are kept so that Prev, Idx and Extract keep working over them. Fetching
+// stops on the first error, which is returned by Err. With the WithPrefetch option, the
+// next page is fetched in the background as soon as the current one is loaded
+func Paged[T any](ctx context.Context, fetch PageFunc[T], opts ...Option) LazyCursor[T] {
+	if fetch == nil {
+		return nil
+	}
+	cfg := newConfig(opts...)
+	ctx, cancel := context.WithCancel(ctx)
+
+	p := &pager[T]{
+		ctx:      ctx,
+		fetch:    fetch,
+		prefetch: cfg.prefetch,
+	}
+	c := newStreamCursor(p.pull, 0)
+	c.src.stop = cancel
+	return c
+}
+
+type page[T any] struct {
+	items []T
+	next  string
+	err   error
+}
+
+type pager[T any] struct {
+	ctx      context.Context
+	fetch    PageFunc[T]
+	prefetch bool
+
+	items   []T
+	token   string
+	last    bool
+	pending chan page[T]
+}
+
+// pull returns the next item in the current page, loading the next page once it is
+// exhausted
+func (p *pager[T]) pull() (T, error) {
+	for len(p.items) == 0 {
+		if p.last {
+			var eof T
+			return eof, io.EOF
+		}
+
+		pg := p.load()
+		if pg.err != nil {
+			var zero T
+			return zero, pg.err
+		}
+
+		p.items, p.token, p.last = pg.items, pg.next, pg.next == ""
+		if p.prefetch && !p.last {
+			p.start(p.token)
+		}
+	}
+
+	v := p.items[0]
+	p.items = p.items[1:]
+	return v, nil
+}
+
+// load returns the page for the current token, either from a pending prefetch or by
+// fetching it
+func (p *pager[T]) load() page[T] {
+	if p.pending != nil {
+		pg := <-p.pending
+		p.pending = nil
+		return pg
+	}
+	if err := p.ctx.Err(); err != nil {
+		return page[T]{err: err}
+	}
+	items, next, err := p.fetch(p.ctx, p.token)
+	return page[T]{items: items, next: next, err: err}
+}
+
+// start fetches the page for `token` in the background
+func (p *pager[T]) start(token string) {
+	pending := make(chan page[T], 1)
+	p.pending = pending
+
+	go func() {
+		items, next, err := p.fetch(p.ctx, token)
+		pending <- page[T]{items: items, next: next, err: err}
+	}()
+}
@@ -0,0 +1,173 @@
+package cur
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type testPage struct {
+	Items []int  `json:"items"`
+	Next  string `json:"next"`
+}
+
+// newPageServer serves `pages` pages of `size` items, signaling each request
+// in `requests`
+func newPageServer(pages, size int, requests chan<- string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("page")
+		if requests != nil {
+			requests <- token
+		}
+
+		n := 0
+		if token != "" {
+			var err error
+			if n, err = strconv.Atoi(token); err != nil || n >= pages {
+				http.Error(w, "invalid page token", http.StatusBadRequest)
+				return
+			}
+		}
+
+		page := testPage{}
+		for i := range size {
+			page.Items = append(page.Items, n*size+i)
+		}
+		if n+1 < pages {
+			page.Next = strconv.Itoa(n + 1)
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+}
+
+func fetchPage(url string) PageFunc[int] {
+	return func(ctx context.Context, token string) ([]int, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"?page="+token, http.NoBody)
+		if err != nil {
+			return nil, "", err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, "", errors.New(res.Status)
+		}
+
+		var page testPage
+		if err = json.NewDecoder(res.Body).Decode(&page); err != nil {
+			return nil, "", err
+		}
+		return page.Items, page.Next, nil
+	}
+}
+
+func TestPaged(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		if Paged[int](context.Background(), nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("Navigate", func(t *testing.T) {
+		requests := make(chan string, 10)
+		srv := newPageServer(3, 4, requests)
+		defer srv.Close()
+
+		c := Paged(context.Background(), fetchPage(srv.URL))
+		if v := c.Next(); v != 0 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 0, v)
+		}
+		if len(requests) != 1 {
+			t.Errorf("unexpected number of requests: wanted %d ; got %d", 1, len(requests))
+		}
+		if v := c.Idx(5); v != 5 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 5, v)
+		}
+		if len(requests) != 2 {
+			t.Errorf("unexpected number of requests: wanted %d ; got %d", 2, len(requests))
+		}
+		if v := c.Offset(-4); v != 1 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 1, v)
+		}
+		if v := c.Tail(); v != 11 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 11, v)
+		}
+		if !c.Exhausted() || c.Len() != 12 {
+			t.Errorf("expected cursor to be exhausted with 12 items ; got %d", c.Len())
+		}
+		if len(requests) != 3 {
+			t.Errorf("unexpected number of requests: wanted %d ; got %d", 3, len(requests))
+		}
+		if got := c.Extract(2, 7); !slices.Equal(got, []int{2, 3, 4, 5, 6}) {
+			t.Errorf("unexpected output: wanted %v ; got %v", []int{2, 3, 4, 5, 6}, got)
+		}
+		if err := Err(c); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	t.Run("Prefetch", func(t *testing.T) {
+		requests := make(chan string, 10)
+		srv := newPageServer(3, 2, requests)
+		defer srv.Close()
+
+		c := Paged(context.Background(), fetchPage(srv.URL), WithPrefetch())
+		c.Next()
+
+		for _, want := range []string{"", "1"} {
+			select {
+			case token := <-requests:
+				if token != want {
+					t.Errorf("unexpected page token: wanted %q ; got %q", want, token)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("timed out waiting for page %q", want)
+			}
+		}
+		if v := c.Idx(3); v != 3 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 3, v)
+		}
+		c.Close()
+	})
+	t.Run("Err", func(t *testing.T) {
+		srv := newPageServer(2, 2, nil)
+		defer srv.Close()
+
+		fetch := fetchPage(srv.URL)
+		c := Paged(context.Background(), func(ctx context.Context, token string) ([]int, string, error) {
+			items, _, err := fetch(ctx, token)
+			return items, "bad", err
+		})
+
+		if v := c.Idx(1); v != 1 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 1, v)
+		}
+		if _, ok := c.PeekOK(); ok {
+			t.Errorf("expected EOF")
+		}
+		if err := Err(c); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		srv := newPageServer(2, 2, nil)
+		defer srv.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		c := Paged(ctx, fetchPage(srv.URL))
+		if _, ok := c.NextOK(); ok {
+			t.Errorf("expected EOF")
+		}
+		if err := Err(c); !errors.Is(err, context.Canceled) {
+			t.Errorf("unexpected error: wanted %v ; got %v", context.Canceled, err)
+		}
+	})
+}
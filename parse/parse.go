@@ -0,0 +1,209 @@
+// Package parse implements parser combinators over a cur.Cursor
+//
+// A Parser consumes items from the cursor and returns its result, along with whether it
+// succeeded. Parsers that fail leave the cursor in the position they were called in, so
+// grammars can backtrack without saving and restoring positions by hand
+package parse
+
+import "github.com/zalgonoise/cur"
+
+// Parser parses items from the cursor `c`, returning the result and whether it succeeded
+//
+// The cursor is left in the same position if the parser fails
+type Parser[T, R any] func(c cur.Cursor[T]) (R, bool)
+
+// Satisfy returns a Parser that consumes the item in the current position if it matches
+// `pred`, returning it
+func Satisfy[T any](pred func(T) bool) Parser[T, T] {
+	return func(c cur.Cursor[T]) (T, bool) {
+		cc := cur.Check(c)
+		v, ok := cc.CurOK()
+		if !ok || !pred(v) {
+			var zero T
+			return zero, false
+		}
+		cc.NextOK()
+		return v, true
+	}
+}
+
+// Lit returns a Parser that consumes the item in the current position if it is equal
+// to `v`, returning it
+func Lit[T comparable](v T) Parser[T, T] {
+	return Satisfy(func(item T) bool {
+		return item == v
+	})
+}
+
+// Seq returns a Parser that runs each of the parsers `ps` in sequence, returning their
+// results, and failing if any of them fails
+func Seq[T, R any](ps ...Parser[T, R]) Parser[T, []R] {
+	return func(c cur.Cursor[T]) ([]R, bool) {
+		m := c.Mark()
+		res := make([]R, 0, len(ps))
+		for _, p := range ps {
+			v, ok := p(c)
+			if !ok {
+				c.Reset(m)
+				return nil, false
+			}
+			res = append(res, v)
+		}
+		return res, true
+	}
+}
+
+// Alt returns a Parser that runs the parsers `ps` in order, returning the result of the
+// first one to succeed, and failing if all of them fail
+func Alt[T, R any](ps ...Parser[T, R]) Parser[T, R] {
+	return func(c cur.Cursor[T]) (R, bool) {
+		m := c.Mark()
+		for _, p := range ps {
+			if v, ok := p(c); ok {
+				return v, true
+			}
+			c.Reset(m)
+		}
+		var zero R
+		return zero, false
+	}
+}
+
+// Many returns a Parser that runs the parser `p` as many times as it succeeds, returning
+// its results. It always succeeds, even if `p` doesn't match at all
+//
+// Repetition stops if `p` succeeds without consuming any items
+func Many[T, R any](p Parser[T, R]) Parser[T, []R] {
+	return func(c cur.Cursor[T]) ([]R, bool) {
+		var res []R
+		for {
+			m := c.Mark()
+			v, ok := p(c)
+			if !ok {
+				c.Reset(m)
+				return res, true
+			}
+			res = append(res, v)
+			if c.Mark() == m {
+				return res, true
+			}
+		}
+	}
+}
+
+// Many1 is like Many, but fails if the parser `p` doesn't succeed at least once
+func Many1[T, R any](p Parser[T, R]) Parser[T, []R] {
+	many := Many(p)
+	return func(c cur.Cursor[T]) ([]R, bool) {
+		res, _ := many(c)
+		if len(res) == 0 {
+			return nil, false
+		}
+		return res, true
+	}
+}
+
+// Optional returns a Parser that runs the parser `p`, returning its result, or the
+// zero-value for R if it fails. It always succeeds
+func Optional[T, R any](p Parser[T, R]) Parser[T, R] {
+	return func(c cur.Cursor[T]) (R, bool) {
+		m := c.Mark()
+		v, ok := p(c)
+		if !ok {
+			c.Reset(m)
+			var zero R
+			return zero, true
+		}
+		return v, true
+	}
+}
+
+// SepBy returns a Parser that runs the parser `p` as many times as it succeeds, with the
+// parser `sep` matching in between, returning the results of `p`. It always succeeds,
+// even if `p` doesn't match at all
+//
+// A trailing separator is not consumed
+func SepBy[T, R, S any](p Parser[T, R], sep Parser[T, S]) Parser[T, []R] {
+	return func(c cur.Cursor[T]) ([]R, bool) {
+		m := c.Mark()
+		v, ok := p(c)
+		if !ok {
+			c.Reset(m)
+			return nil, true
+		}
+
+		res := []R{v}
+		for {
+			m = c.Mark()
+			if _, ok = sep(c); !ok {
+				c.Reset(m)
+				return res, true
+			}
+			if v, ok = p(c); !ok {
+				c.Reset(m)
+				return res, true
+			}
+			res = append(res, v)
+		}
+	}
+}
+
+// Between returns a Parser that runs the parsers `open`, `p` and `close` in sequence,
+// returning the result of `p`
+func Between[T, O, R, C any](open Parser[T, O], p Parser[T, R], close Parser[T, C]) Parser[T, R] {
+	return func(c cur.Cursor[T]) (R, bool) {
+		var zero R
+		m := c.Mark()
+		if _, ok := open(c); !ok {
+			c.Reset(m)
+			return zero, false
+		}
+		v, ok := p(c)
+		if !ok {
+			c.Reset(m)
+			return zero, false
+		}
+		if _, ok = close(c); !ok {
+			c.Reset(m)
+			return zero, false
+		}
+		return v, true
+	}
+}
+
+// Map returns a Parser that runs the parser `p`, returning its result converted with
+// the function `fn`
+func Map[T, A, B any](p Parser[T, A], fn func(A) B) Parser[T, B] {
+	return func(c cur.Cursor[T]) (B, bool) {
+		m := c.Mark()
+		v, ok := p(c)
+		if !ok {
+			c.Reset(m)
+			var zero B
+			return zero, false
+		}
+		return fn(v), true
+	}
+}
+
+// Lookahead returns a Parser that runs the parser `p`, returning its result without
+// consuming any items
+func Lookahead[T, R any](p Parser[T, R]) Parser[T, R] {
+	return func(c cur.Cursor[T]) (R, bool) {
+		m := c.Mark()
+		v, ok := p(c)
+		c.Reset(m)
+		return v, ok
+	}
+}
+
+// Not returns a Parser that succeeds if the parser `p` fails, without consuming any
+// items
+func Not[T, R any](p Parser[T, R]) Parser[T, struct{}] {
+	return func(c cur.Cursor[T]) (struct{}, bool) {
+		m := c.Mark()
+		_, ok := p(c)
+		c.Reset(m)
+		return struct{}{}, !ok
+	}
+}
@@ -0,0 +1,155 @@
+package parse
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"unicode"
+
+	"github.com/zalgonoise/cur"
+)
+
+var (
+	digit  = Satisfy(unicode.IsDigit)
+	number = Map(Many1(digit), func(rs []rune) int {
+		n, _ := strconv.Atoi(string(rs))
+		return n
+	})
+	list = Between(Lit('['), SepBy(number, Lit(',')), Lit(']'))
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Satisfy", func(t *testing.T) {
+		c := cur.New([]rune("1a"))
+		if v, ok := digit(c); !ok || v != '1' {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", '1', v, ok)
+		}
+		if _, ok := digit(c); ok {
+			t.Errorf("expected no match")
+		}
+		c.Next()
+		if _, ok := digit(c); ok {
+			t.Errorf("expected EOF")
+		}
+	})
+	t.Run("Seq", func(t *testing.T) {
+		p := Seq(Lit('a'), Lit('b'), Lit('c'))
+		c := cur.New([]rune("abd"))
+		if _, ok := p(c); ok {
+			t.Errorf("expected no match")
+		}
+		if c.Pos() != 0 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+		}
+
+		c = cur.New([]rune("abc"))
+		if v, ok := p(c); !ok || string(v) != "abc" {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", "abc", string(v), ok)
+		}
+	})
+	t.Run("Alt", func(t *testing.T) {
+		p := Alt(Seq(Lit('a'), Lit('b')), Seq(Lit('a'), Lit('c')))
+		c := cur.New([]rune("ac"))
+		if v, ok := p(c); !ok || string(v) != "ac" {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", "ac", string(v), ok)
+		}
+
+		c = cur.New([]rune("ad"))
+		if _, ok := p(c); ok {
+			t.Errorf("expected no match")
+		}
+		if c.Pos() != 0 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+		}
+	})
+	t.Run("Many", func(t *testing.T) {
+		c := cur.New([]rune("12a"))
+		if v, ok := Many(digit)(c); !ok || string(v) != "12" {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", "12", string(v), ok)
+		}
+		if v, ok := Many(digit)(c); !ok || len(v) != 0 {
+			t.Errorf("unexpected value: wanted %q ; got %q (%v)", "", string(v), ok)
+		}
+		if _, ok := Many1(digit)(c); ok {
+			t.Errorf("expected no match")
+		}
+		if v, ok := Many(Optional(digit))(c); !ok || len(v) != 1 {
+			t.Errorf("expected repetition to stop when not consuming items")
+		}
+	})
+	t.Run("Optional", func(t *testing.T) {
+		p := Seq(Optional(Lit('-')), digit)
+		for _, test := range []struct {
+			input string
+			wants string
+		}{
+			{"-1", "-1"},
+			{"1", "\x001"},
+		} {
+			v, ok := p(cur.New([]rune(test.input)))
+			if !ok || string(v) != test.wants {
+				t.Errorf("unexpected value: wanted %q ; got %q (%v)", test.wants, string(v), ok)
+			}
+		}
+
+		failing := func(cur.Cursor[rune]) (int, bool) { return 42, false }
+		if v, ok := Optional(failing)(cur.New([]rune("x"))); !ok || v != 0 {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", 0, v, ok)
+		}
+	})
+	t.Run("SepBy", func(t *testing.T) {
+		for _, test := range []struct {
+			input string
+			wants []int
+			ok    bool
+			pos   int
+		}{
+			{"[1,22,333]", []int{1, 22, 333}, true, -1},
+			{"[]", nil, true, -1},
+			{"[1,]", nil, false, 0},
+			{"[1,2", nil, false, 0},
+		} {
+			c := cur.New([]rune(test.input))
+			v, ok := list(c)
+			if ok != test.ok || !slices.Equal(v, test.wants) {
+				t.Errorf("unexpected value for %q: wanted %v ; got %v (%v)", test.input, test.wants, v, ok)
+			}
+			if c.Pos() != test.pos {
+				t.Errorf("unexpected position for %q: wanted %d ; got %d", test.input, test.pos, c.Pos())
+			}
+		}
+	})
+	t.Run("Lookahead", func(t *testing.T) {
+		c := cur.New([]rune("12"))
+		if v, ok := Lookahead(number)(c); !ok || v != 12 {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", 12, v, ok)
+		}
+		if _, ok := Not(number)(c); ok {
+			t.Errorf("expected no match")
+		}
+		if c.Pos() != 0 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+		}
+		if _, ok := Not(Lit('['))(c); !ok {
+			t.Errorf("expected a match")
+		}
+	})
+	t.Run("Recursive", func(t *testing.T) {
+		// nested := '(' nested ')' | 'x'
+		var nested Parser[rune, int]
+		nested = Alt(
+			Between(Lit('('), func(c cur.Cursor[rune]) (int, bool) {
+				n, ok := nested(c)
+				return n + 1, ok
+			}, Lit(')')),
+			Map(Lit('x'), func(rune) int { return 0 }),
+		)
+
+		if v, ok := nested(cur.New([]rune("(((x)))"))); !ok || v != 3 {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", 3, v, ok)
+		}
+		if _, ok := nested(cur.New([]rune("((x)"))); ok {
+			t.Errorf("expected no match")
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package parse
+
+import "github.com/zalgonoise/cur"
+
+// PrefixFunc parses an expression starting with the token `tok`, like a literal, a unary
+// operator or a parenthesized expression, consuming any operands through `s`
+type PrefixFunc[T any, K comparable, N any] func(s *State[T, K, N], tok T) (N, error)
+
+// InfixFunc builds the expression for the binary operator `tok` with its operands
+type InfixFunc[T, N any] func(left N, tok T, right N) (N, error)
+
+// PostfixFunc parses an expression following the expression `left` with the token `tok`,
+// like a postfix operator, a call or an index expression, consuming any further tokens
+// through `s`
+type PostfixFunc[T any, K comparable, N any] func(s *State[T, K, N], left N, tok T) (N, error)
+
+// Pratt is a Pratt parser that builds expressions of type N from a cursor of tokens of
+// type T, dispatching the tokens to their handlers by their kind, of type K
+type Pratt[T any, K comparable, N any] struct {
+	kind   func(T) K
+	prefix map[K]PrefixFunc[T, K, N]
+	led    map[K]led[T, K, N]
+}
+
+// led is the handler for a token following an expression, either infix or postfix
+type led[T any, K comparable, N any] struct {
+	lbp     int
+	rbp     int
+	infix   InfixFunc[T, N]
+	postfix PostfixFunc[T, K, N]
+}
+
+// NewPratt returns a Pratt parser that reads the kind of each token with the function
+// `kind`
+func NewPratt[T any, K comparable, N any](kind func(T) K) *Pratt[T, K, N] {
+	return &Pratt[T, K, N]{
+		kind:   kind,
+		prefix: map[K]PrefixFunc[T, K, N]{},
+		led:    map[K]led[T, K, N]{},
+	}
+}
+
+// Prefix registers the handler for tokens of kind `k` starting an expression
+func (p *Pratt[T, K, N]) Prefix(k K, fn PrefixFunc[T, K, N]) {
+	p.prefix[k] = fn
+}
+
+// Infix registers the handler for binary operators of kind `k`, with the left and right
+// binding powers `lbp` and `rbp`. Operators with a higher binding power bind tighter
+//
+// Left-associative operators have a right binding power higher than their left one, like
+// (1, 2), and right-associative operators a lower one, like (2, 1). Registering an infix
+// handler replaces any postfix handler for the same kind
+func (p *Pratt[T, K, N]) Infix(k K, lbp, rbp int, fn InfixFunc[T, N]) {
+	p.led[k] = led[T, K, N]{lbp: lbp, rbp: rbp, infix: fn}
+}
+
+// Postfix registers the handler for tokens of kind `k` following an expression, with the
+// left binding power `lbp`
+//
+// Registering a postfix handler replaces any infix handler for the same kind
+func (p *Pratt[T, K, N]) Postfix(k K, lbp int, fn PostfixFunc[T, K, N]) {
+	p.led[k] = led[T, K, N]{lbp: lbp, postfix: fn}
+}
+
+// Parse parses an expression from the current position of the cursor `c`, stopping
+// before the first token that has no infix or postfix handler
+//
+// The cursor is left in the same position if parsing fails. A token without a prefix
+// handler where an expression is expected raises a *cur.UnexpectedError, and the end of
+// the input raises a *cur.BoundsError
+func (p *Pratt[T, K, N]) Parse(c cur.Cursor[T]) (N, error) {
+	m := c.Mark()
+	s := &State[T, K, N]{p: p, c: cur.Check(c)}
+	n, err := s.Expr(0)
+	if err != nil {
+		c.Reset(m)
+	}
+	return n, err
+}
+
+// Parser returns the Pratt parser as a Parser, to combine it with other parsers
+func (p *Pratt[T, K, N]) Parser() Parser[T, N] {
+	return func(c cur.Cursor[T]) (N, bool) {
+		n, err := p.Parse(c)
+		return n, err == nil
+	}
+}
+
+// State is the state of a Pratt parser while parsing an expression, passed to the prefix
+// and postfix handlers to consume further tokens
+type State[T any, K comparable, N any] struct {
+	p *Pratt[T, K, N]
+	c cur.CheckedCursor[T]
+}
+
+// Cursor returns the cursor being parsed
+func (s *State[T, K, N]) Cursor() cur.CheckedCursor[T] {
+	return s.c
+}
+
+// Peek returns the next token without consuming it, and whether the end of the input was
+// not yet reached
+func (s *State[T, K, N]) Peek() (T, bool) {
+	return s.c.CurOK()
+}
+
+// Next consumes the next token, returning it and whether the end of the input was not
+// yet reached
+func (s *State[T, K, N]) Next() (T, bool) {
+	return s.c.NextOK()
+}
+
+// Expect consumes the next token if it is of kind `k`, returning it, or returns a
+// *cur.UnexpectedError otherwise
+func (s *State[T, K, N]) Expect(k K) (T, error) {
+	tok, ok := s.c.CurOK()
+	if !ok {
+		return tok, s.eof("Expect")
+	}
+	if got := s.p.kind(tok); got != k {
+		return tok, &cur.UnexpectedError{Index: int(s.c.Mark()), Want: k, Got: got}
+	}
+	s.c.NextOK()
+	return tok, nil
+}
+
+// Expr parses an expression whose operators bind tighter than the binding power `bp`,
+// like the operand of a unary operator or the contents of parentheses, with a `bp` of 0
+func (s *State[T, K, N]) Expr(bp int) (N, error) {
+	var left N
+
+	tok, ok := s.c.CurOK()
+	if !ok {
+		return left, s.eof("Expr")
+	}
+	prefix, ok := s.p.prefix[s.p.kind(tok)]
+	if !ok {
+		return left, &cur.UnexpectedError{Index: int(s.c.Mark()), Want: "expression", Got: s.p.kind(tok)}
+	}
+	s.c.NextOK()
+
+	left, err := prefix(s, tok)
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		tok, ok = s.c.CurOK()
+		if !ok {
+			return left, nil
+		}
+		rule, ok := s.p.led[s.p.kind(tok)]
+		if !ok || rule.lbp < bp {
+			return left, nil
+		}
+		s.c.NextOK()
+
+		if rule.postfix != nil {
+			if left, err = rule.postfix(s, left, tok); err != nil {
+				return left, err
+			}
+			continue
+		}
+
+		right, err := s.Expr(rule.rbp)
+		if err != nil {
+			return left, err
+		}
+		if left, err = rule.infix(left, tok, right); err != nil {
+			return left, err
+		}
+	}
+}
+
+func (s *State[T, K, N]) eof(op string) error {
+	return &cur.BoundsError{Op: op, Index: int(s.c.Mark()), Len: s.c.Len()}
+}
@@ -0,0 +1,169 @@
+package parse
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/zalgonoise/cur"
+	"github.com/zalgonoise/cur/lex"
+)
+
+const (
+	tokNumber lex.Kind = iota + 1
+	tokIdent
+	tokPunct
+)
+
+func lexExpr(l *lex.Lexer[rune]) lex.StateFn[rune] {
+	cur.SkipWhile(l.Cursor(), unicode.IsSpace)
+	l.Ignore()
+
+	r, ok := l.Next()
+	switch {
+	case !ok:
+		return nil
+	case unicode.IsDigit(r):
+		cur.SkipWhile(l.Cursor(), unicode.IsDigit)
+		l.Emit(tokNumber)
+	case unicode.IsLetter(r):
+		cur.SkipWhile(l.Cursor(), unicode.IsLetter)
+		l.Emit(tokIdent)
+	case strings.ContainsRune("+-*/^!()[],", r):
+		l.Emit(tokPunct)
+	default:
+		return l.Errorf("unexpected character %q", r)
+	}
+	return lexExpr
+}
+
+func tokens(src string) cur.Cursor[lex.Token] {
+	return cur.New(slices.Collect(lex.New(cur.Runes(src), lexExpr).Tokens()))
+}
+
+func kind(tok lex.Token) string {
+	switch tok.Kind {
+	case tokNumber:
+		return "number"
+	case tokIdent:
+		return "ident"
+	}
+	return tok.Value
+}
+
+func newSexpr() *Pratt[lex.Token, string, string] {
+	p := NewPratt[lex.Token, string, string](kind)
+
+	literal := func(_ *State[lex.Token, string, string], tok lex.Token) (string, error) {
+		return tok.Value, nil
+	}
+	binary := func(left string, tok lex.Token, right string) (string, error) {
+		return "(" + tok.Value + " " + left + " " + right + ")", nil
+	}
+
+	p.Prefix("number", literal)
+	p.Prefix("ident", literal)
+	p.Prefix("-", func(s *State[lex.Token, string, string], tok lex.Token) (string, error) {
+		operand, err := s.Expr(5)
+		return "(- " + operand + ")", err
+	})
+	p.Prefix("(", func(s *State[lex.Token, string, string], tok lex.Token) (string, error) {
+		expr, err := s.Expr(0)
+		if err != nil {
+			return "", err
+		}
+		_, err = s.Expect(")")
+		return expr, err
+	})
+
+	p.Infix("+", 1, 2, binary)
+	p.Infix("-", 1, 2, binary)
+	p.Infix("*", 3, 4, binary)
+	p.Infix("/", 3, 4, binary)
+	p.Infix("^", 8, 7, binary)
+
+	p.Postfix("!", 9, func(_ *State[lex.Token, string, string], left string, tok lex.Token) (string, error) {
+		return "(! " + left + ")", nil
+	})
+	p.Postfix("[", 10, func(s *State[lex.Token, string, string], left string, tok lex.Token) (string, error) {
+		idx, err := s.Expr(0)
+		if err != nil {
+			return "", err
+		}
+		_, err = s.Expect("]")
+		return "([ " + left + " " + idx + ")", err
+	})
+
+	return p
+}
+
+func TestPratt(t *testing.T) {
+	p := newSexpr()
+
+	for _, test := range []struct {
+		input string
+		wants string
+		pos   int
+	}{
+		{"1 + 2 * 3", "(+ 1 (* 2 3))", -1},
+		{"1 * 2 + 3", "(+ (* 1 2) 3)", -1},
+		{"1 - 2 - 3", "(- (- 1 2) 3)", -1},
+		{"2 ^ 3 ^ 4", "(^ 2 (^ 3 4))", -1},
+		{"-a!", "(- (! a))", -1},
+		{"-a ^ b", "(- (^ a b))", -1},
+		{"(1 + 2) * x[i + 1]", "(* (+ 1 2) ([ x (+ i 1)))", -1},
+		{"a b", "a", 1},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			c := tokens(test.input)
+			v, err := p.Parse(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v != test.wants {
+				t.Errorf("unexpected value: wanted %q ; got %q", test.wants, v)
+			}
+			if c.Pos() != test.pos {
+				t.Errorf("unexpected position: wanted %d ; got %d", test.pos, c.Pos())
+			}
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, test := range []struct {
+			input string
+			err   error
+		}{
+			{"1 + )", cur.ErrUnexpected},
+			{"(1 + 2]", cur.ErrUnexpected},
+			{"1 +", cur.ErrOutOfBounds},
+			{"(1", cur.ErrOutOfBounds},
+		} {
+			c := tokens(test.input)
+			if _, err := p.Parse(c); !errors.Is(err, test.err) {
+				t.Errorf("unexpected error for %q: wanted %v ; got %v", test.input, test.err, err)
+			}
+			if c.Pos() != 0 {
+				t.Errorf("unexpected position for %q: wanted %d ; got %d", test.input, 0, c.Pos())
+			}
+		}
+
+		var target *cur.UnexpectedError
+		if _, err := p.Parse(tokens("1 + )")); !errors.As(err, &target) || target.Index != 2 || target.Got != ")" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Parser", func(t *testing.T) {
+		comma := Satisfy(func(tok lex.Token) bool { return tok.Value == "," })
+		list := SepBy(p.Parser(), comma)
+
+		v, ok := list(tokens("1, 2 * 3, -x"))
+		wants := []string{"1", "(* 2 3)", "(- x)"}
+		if !ok || !slices.Equal(v, wants) {
+			t.Errorf("unexpected value: wanted %v ; got %v (%v)", wants, v, ok)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package pattern
+
+type opcode uint8
+
+const (
+	opMatch opcode = iota
+	opItem
+	opSplit
+	opJmp
+	opSave
+	opStart
+	opEnd
+	opLook
+)
+
+// inst is an instruction in a compiled program
+type inst[T any] struct {
+	op   opcode
+	pred func(T) bool
+	// x is the jump target for opJmp and the preferred one for opSplit, and the capture
+	// slot for opSave
+	x int
+	// y is the alternative jump target for opSplit
+	y    int
+	look *lookaround[T]
+}
+
+// lookaround is the program of a lookaround assertion
+type lookaround[T any] struct {
+	prog   []inst[T]
+	groups int
+	behind bool
+	negate bool
+}
+
+type compiler[T any] struct {
+	prog   []inst[T]
+	groups *int
+}
+
+func (c *compiler[T]) emit(i inst[T]) int {
+	c.prog = append(c.prog, i)
+	return len(c.prog) - 1
+}
+
+func (p pred[T]) compile(c *compiler[T]) {
+	c.emit(inst[T]{op: opItem, pred: p.fn})
+}
+
+func (p seq[T]) compile(c *compiler[T]) {
+	for _, sub := range p.ps {
+		sub.compile(c)
+	}
+}
+
+func (p alt[T]) compile(c *compiler[T]) {
+	if len(p.ps) == 0 {
+		return
+	}
+	var jumps []int
+	for _, sub := range p.ps[:len(p.ps)-1] {
+		split := c.emit(inst[T]{op: opSplit})
+		c.prog[split].x = len(c.prog)
+		sub.compile(c)
+		jumps = append(jumps, c.emit(inst[T]{op: opJmp}))
+		c.prog[split].y = len(c.prog)
+	}
+	p.ps[len(p.ps)-1].compile(c)
+	for _, j := range jumps {
+		c.prog[j].x = len(c.prog)
+	}
+}
+
+func (p star[T]) compile(c *compiler[T]) {
+	split := c.emit(inst[T]{op: opSplit})
+	c.prog[split].x = len(c.prog)
+	p.p.compile(c)
+	c.emit(inst[T]{op: opJmp, x: split})
+	c.prog[split].y = len(c.prog)
+}
+
+func (p plus[T]) compile(c *compiler[T]) {
+	start := len(c.prog)
+	p.p.compile(c)
+	c.emit(inst[T]{op: opSplit, x: start, y: len(c.prog) + 1})
+}
+
+func (p opt[T]) compile(c *compiler[T]) {
+	split := c.emit(inst[T]{op: opSplit})
+	c.prog[split].x = len(c.prog)
+	p.p.compile(c)
+	c.prog[split].y = len(c.prog)
+}
+
+func (p group[T]) compile(c *compiler[T]) {
+	n := *c.groups
+	*c.groups++
+	c.emit(inst[T]{op: opSave, x: 2 * n})
+	p.p.compile(c)
+	c.emit(inst[T]{op: opSave, x: 2*n + 1})
+}
+
+func (p assert[T]) compile(c *compiler[T]) {
+	if p.end {
+		c.emit(inst[T]{op: opEnd})
+		return
+	}
+	c.emit(inst[T]{op: opStart})
+}
+
+func (p look[T]) compile(c *compiler[T]) {
+	sub := &compiler[T]{groups: new(int)}
+	p.p.compile(sub)
+	sub.emit(inst[T]{op: opMatch})
+	c.emit(inst[T]{op: opLook, look: &lookaround[T]{
+		prog:   sub.prog,
+		groups: *sub.groups,
+		behind: p.behind,
+		negate: p.negate,
+	}})
+}
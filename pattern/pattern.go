@@ -0,0 +1,120 @@
+// Package pattern implements regular expressions over items of any type, matched
+// against a cur.Cursor
+//
+// Patterns are built from predicates on the items, combined with the usual regular
+// expression operators, and compiled into a program run by a backtracking matcher that
+// memoizes failed states, which bounds the work done to the size of the program times
+// the size of the input, outside of lookbehinds
+package pattern
+
+// Pattern is a regular expression over items of type T, built with the functions in
+// this package
+type Pattern[T any] interface {
+	compile(c *compiler[T])
+}
+
+type (
+	pred[T any]  struct{ fn func(T) bool }
+	seq[T any]   struct{ ps []Pattern[T] }
+	alt[T any]   struct{ ps []Pattern[T] }
+	star[T any]  struct{ p Pattern[T] }
+	plus[T any]  struct{ p Pattern[T] }
+	opt[T any]   struct{ p Pattern[T] }
+	group[T any] struct{ p Pattern[T] }
+
+	assert[T any] struct{ end bool }
+
+	look[T any] struct {
+		p      Pattern[T]
+		behind bool
+		negate bool
+	}
+)
+
+// Lit matches the sequence of items `vs`
+func Lit[T comparable](vs ...T) Pattern[T] {
+	ps := make([]Pattern[T], 0, len(vs))
+	for _, v := range vs {
+		ps = append(ps, Pred(func(item T) bool {
+			return item == v
+		}))
+	}
+	return Seq(ps...)
+}
+
+// Pred matches a single item that satisfies `fn`
+func Pred[T any](fn func(T) bool) Pattern[T] {
+	return pred[T]{fn}
+}
+
+// Any matches any single item
+func Any[T any]() Pattern[T] {
+	return pred[T]{}
+}
+
+// Seq matches the patterns `ps` in sequence
+func Seq[T any](ps ...Pattern[T]) Pattern[T] {
+	return seq[T]{ps}
+}
+
+// Alt matches the first of the patterns `ps` that leads to a match
+func Alt[T any](ps ...Pattern[T]) Pattern[T] {
+	return alt[T]{ps}
+}
+
+// Star matches the pattern `p` zero or more times, as many times as possible
+func Star[T any](p Pattern[T]) Pattern[T] {
+	return star[T]{p}
+}
+
+// Plus matches the pattern `p` one or more times, as many times as possible
+func Plus[T any](p Pattern[T]) Pattern[T] {
+	return plus[T]{p}
+}
+
+// Opt matches the pattern `p` zero or one times, preferring one
+func Opt[T any](p Pattern[T]) Pattern[T] {
+	return opt[T]{p}
+}
+
+// Group matches the patterns `ps` in sequence, capturing the span of the matched items
+//
+// Groups are numbered from zero in the order they are opened within the pattern. Groups
+// within lookarounds are not captured nor numbered
+func Group[T any](ps ...Pattern[T]) Pattern[T] {
+	return group[T]{Seq(ps...)}
+}
+
+// Start matches the beginning of the input, without consuming any items
+func Start[T any]() Pattern[T] {
+	return assert[T]{end: false}
+}
+
+// End matches the end of the input, without consuming any items
+func End[T any]() Pattern[T] {
+	return assert[T]{end: true}
+}
+
+// Ahead matches if the pattern `p` matches from the current position, without consuming
+// any items
+func Ahead[T any](p Pattern[T]) Pattern[T] {
+	return look[T]{p: p}
+}
+
+// NotAhead matches if the pattern `p` doesn't match from the current position, without
+// consuming any items
+func NotAhead[T any](p Pattern[T]) Pattern[T] {
+	return look[T]{p: p, negate: true}
+}
+
+// Behind matches if the pattern `p` matches the items right before the current position,
+// without consuming any items
+func Behind[T any](p Pattern[T]) Pattern[T] {
+	return look[T]{p: p, behind: true}
+}
+
+// NotBehind matches if the pattern `p` doesn't match the items right before the current
+// position, without consuming any items
+func NotBehind[T any](p Pattern[T]) Pattern[T] {
+	return look[T]{p: p, behind: true, negate: true}
+}
@@ -0,0 +1,174 @@
+package pattern
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/zalgonoise/cur"
+)
+
+var (
+	digit  = Pred(unicode.IsDigit)
+	letter = Pred(unicode.IsLetter)
+)
+
+func TestPattern(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		p      Pattern[rune]
+		input  string
+		wants  Span
+		groups []Span
+		ok     bool
+	}{
+		{
+			name:   "Lit",
+			p:      Seq(Lit('a', 'b'), Group(Plus(digit))),
+			input:  "xxab123c",
+			wants:  Span{2, 7},
+			groups: []Span{{4, 7}},
+			ok:     true,
+		},
+		{
+			name:  "Alt",
+			p:     Seq(Alt(Lit('a'), Lit('a', 'b')), End[rune]()),
+			input: "ab",
+			wants: Span{0, 2},
+			ok:    true,
+		},
+		{
+			name:  "Star",
+			p:     Seq(Star(Any[rune]()), Lit('c')),
+			input: "abcbcd",
+			wants: Span{0, 5},
+			ok:    true,
+		},
+		{
+			name:   "Opt",
+			p:      Seq(Opt(Group(Lit('-'))), Group(Plus(digit))),
+			input:  "x42",
+			wants:  Span{1, 3},
+			groups: []Span{{-1, -1}, {1, 3}},
+			ok:     true,
+		},
+		{
+			name:  "Start",
+			p:     Seq(Start[rune](), Lit('b')),
+			input: "ab",
+			ok:    false,
+		},
+		{
+			name:  "End",
+			p:     Seq(Plus(digit), End[rune]()),
+			input: "12a34",
+			wants: Span{3, 5},
+			ok:    true,
+		},
+		{
+			name:  "Ahead",
+			p:     Seq(Plus(letter), Ahead(Lit('('))),
+			input: "foo bar(",
+			wants: Span{4, 7},
+			ok:    true,
+		},
+		{
+			name:  "NotAhead",
+			p:     Seq(Plus(digit), NotAhead(Alt(digit, Lit('%')))),
+			input: "10% 20",
+			wants: Span{4, 6},
+			ok:    true,
+		},
+		{
+			name:  "Behind",
+			p:     Seq(Behind(Lit('$')), Plus(digit)),
+			input: "a1 $23",
+			wants: Span{4, 6},
+			ok:    true,
+		},
+		{
+			name:  "NotBehind",
+			p:     Seq(NotBehind(Alt(letter, digit)), Plus(digit)),
+			input: "a1 $23",
+			wants: Span{4, 6},
+			ok:    true,
+		},
+		{
+			name:   "LookGroups",
+			p:      Seq(Ahead(Group(letter)), Group(Any[rune]())),
+			input:  "1a",
+			wants:  Span{1, 2},
+			groups: []Span{{1, 2}},
+			ok:     true,
+		},
+		{
+			name:  "EmptyLoop",
+			p:     Star(Opt(Lit('a'))),
+			input: "aab",
+			wants: Span{0, 2},
+			ok:    true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := cur.New([]rune(test.input))
+			m, ok := Compile(test.p).FindNext(c)
+			if ok != test.ok {
+				t.Fatalf("unexpected result: wanted %v ; got %v", test.ok, ok)
+			}
+			if !ok {
+				return
+			}
+			if m.Span != test.wants {
+				t.Errorf("unexpected span: wanted %v ; got %v", test.wants, m.Span)
+			}
+			if test.groups != nil && !slices.Equal(m.Groups, test.groups) {
+				t.Errorf("unexpected groups: wanted %v ; got %v", test.groups, m.Groups)
+			}
+			if c.Pos() != 0 {
+				t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+			}
+		})
+	}
+
+	t.Run("MatchAt", func(t *testing.T) {
+		re := Compile(Seq(Lit('a', 'b'), Plus(digit)))
+		c := cur.New([]rune("xxab123c"))
+		if _, ok := re.MatchAt(c); ok {
+			t.Errorf("expected no match")
+		}
+		c.Idx(2)
+		m, ok := re.MatchAt(c)
+		if !ok || m.Span != (Span{2, 7}) {
+			t.Errorf("unexpected span: wanted %v ; got %v (%v)", Span{2, 7}, m.Span, ok)
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+		c.Idx(3)
+		if m, ok = re.FindNext(c); ok {
+			t.Errorf("unexpected match: %v", m)
+		}
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		events := strings.Fields("login view view buy logout login logout")
+		re := Compile(Seq(
+			Lit("login"),
+			Group(Star(Pred(func(e string) bool { return e != "logout" && e != "buy" }))),
+			Lit("buy"),
+		))
+		m, ok := re.FindNext(cur.New(events))
+		if !ok || m.Span != (Span{0, 4}) || m.Groups[0] != (Span{1, 3}) {
+			t.Errorf("unexpected match: %v (%v)", m, ok)
+		}
+	})
+
+	t.Run("Pathological", func(t *testing.T) {
+		re := Compile(Seq(Star(Star(Lit('a'))), Lit('b')))
+		c := cur.New([]rune(strings.Repeat("a", 2000)))
+		if _, ok := re.MatchAt(c); ok {
+			t.Errorf("expected no match")
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package pattern
+
+import "github.com/zalgonoise/cur"
+
+// Span is the range of indices [Start, End) of the items in a match
+type Span struct {
+	Start int
+	End   int
+}
+
+// Match is a successful match of a Regexp
+type Match struct {
+	Span
+	// Groups holds the spans captured by each group in the pattern, or {-1, -1} for the
+	// groups that didn't take part in the match
+	Groups []Span
+}
+
+// Regexp is a compiled Pattern, safe for concurrent use
+type Regexp[T any] struct {
+	prog   []inst[T]
+	groups int
+}
+
+// Compile compiles the Pattern `p` into a Regexp
+func Compile[T any](p Pattern[T]) *Regexp[T] {
+	c := &compiler[T]{groups: new(int)}
+	p.compile(c)
+	c.emit(inst[T]{op: opMatch})
+	return &Regexp[T]{prog: c.prog, groups: *c.groups}
+}
+
+// MatchAt matches the Regexp against the items in the Cursor `c` starting from its
+// current position, returning the match and whether there was one, without moving it
+func (re *Regexp[T]) MatchAt(c cur.Cursor[T]) (Match, bool) {
+	m := newMatcher(re.prog, re.groups, cur.Check(c))
+	return m.match(int(c.Mark()))
+}
+
+// FindNext looks for the first match of the Regexp in the items of the Cursor `c`, from
+// its current position onwards, returning the match and whether there was one, without
+// moving it
+func (re *Regexp[T]) FindNext(c cur.Cursor[T]) (Match, bool) {
+	m := newMatcher(re.prog, re.groups, cur.Check(c))
+	for start := int(c.Mark()); ; start++ {
+		if match, ok := m.match(start); ok {
+			return match, true
+		}
+		if _, ok := m.c.PeekIdxOK(start); !ok {
+			return Match{}, false
+		}
+	}
+}
+
+// thread is an entry in the backtracking stack: either a thread to resume in instruction
+// `pc` and index `pos`, or, if `slot` is not negative, a capture to restore to `pos`
+type thread struct {
+	pc   int
+	pos  int
+	slot int
+}
+
+// matcher runs a program against a cursor, memoizing the states that failed to match
+type matcher[T any] struct {
+	prog   []inst[T]
+	c      cur.CheckedCursor[T]
+	caps   []int
+	stack  []thread
+	failed map[[2]int]struct{}
+	// limit is the index where a lookbehind program must end its match, or -1
+	limit int
+}
+
+func newMatcher[T any](prog []inst[T], groups int, c cur.CheckedCursor[T]) *matcher[T] {
+	return &matcher[T]{
+		prog:   prog,
+		c:      c,
+		caps:   make([]int, 2*groups),
+		failed: map[[2]int]struct{}{},
+		limit:  -1,
+	}
+}
+
+// match runs the program from index `start`
+//
+// The failed states are kept across calls, as whether a state matches doesn't depend on
+// the index where the match started
+func (m *matcher[T]) match(start int) (Match, bool) {
+	for i := range m.caps {
+		m.caps[i] = -1
+	}
+	m.stack = append(m.stack[:0], thread{pc: 0, pos: start, slot: -1})
+
+	for len(m.stack) > 0 {
+		t := m.stack[len(m.stack)-1]
+		m.stack = m.stack[:len(m.stack)-1]
+		if t.slot >= 0 {
+			m.caps[t.slot] = t.pos
+			continue
+		}
+		if end, ok := m.run(t.pc, t.pos); ok {
+			match := Match{Span: Span{Start: start, End: end}, Groups: make([]Span, len(m.caps)/2)}
+			for i := range match.Groups {
+				match.Groups[i] = Span{Start: m.caps[2*i], End: m.caps[2*i+1]}
+			}
+			return match, true
+		}
+	}
+	return Match{}, false
+}
+
+// run executes the thread in instruction `pc` and index `pos` until it matches, returning
+// the index where the match ends, or fails
+func (m *matcher[T]) run(pc, pos int) (int, bool) {
+	for {
+		state := [2]int{pc, pos}
+		if _, ok := m.failed[state]; ok {
+			return 0, false
+		}
+		m.failed[state] = struct{}{}
+
+		in := m.prog[pc]
+		switch in.op {
+		case opMatch:
+			if m.limit >= 0 && pos != m.limit {
+				return 0, false
+			}
+			return pos, true
+		case opItem:
+			v, ok := m.c.PeekIdxOK(pos)
+			if !ok || (in.pred != nil && !in.pred(v)) {
+				return 0, false
+			}
+			pc, pos = pc+1, pos+1
+		case opSplit:
+			m.stack = append(m.stack, thread{pc: in.y, pos: pos, slot: -1})
+			pc = in.x
+		case opJmp:
+			pc = in.x
+		case opSave:
+			m.stack = append(m.stack, thread{pos: m.caps[in.x], slot: in.x})
+			m.caps[in.x] = pos
+			pc++
+		case opStart:
+			if pos != 0 {
+				return 0, false
+			}
+			pc++
+		case opEnd:
+			if _, ok := m.c.PeekIdxOK(pos); ok {
+				return 0, false
+			}
+			pc++
+		case opLook:
+			if m.look(in.look, pos) == in.look.negate {
+				return 0, false
+			}
+			pc++
+		}
+	}
+}
+
+// look returns whether the lookaround `l` matches in index `pos`
+func (m *matcher[T]) look(l *lookaround[T], pos int) bool {
+	if !l.behind {
+		_, ok := newMatcher(l.prog, l.groups, m.c).match(pos)
+		return ok
+	}
+
+	sub := newMatcher(l.prog, l.groups, m.c)
+	sub.limit = pos
+	for start := pos; start >= 0; start-- {
+		if _, ok := sub.match(start); ok {
+			return true
+		}
+	}
+	return false
+}
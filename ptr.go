@@ -1,32 +1,51 @@
 package cur
 
+import "iter"
+
 type ptrCursor[T any] struct {
-	slice *[]T
-	pos   int
+	slice  *[]T
+	pos    int
+	strict bool
+	err    error
+	tx     txStack
+	marks  marks
+
+	seen     snapshot
+	changed  bool
+	resized  error
+	policy   ResizePolicy
+	onResize func(oldLen, newLen int)
 }
 
-// NewCursor returns a Cursor for the input slice, or nil if the slice is empty
-func Ptr[T any](slice *[]T) Cursor[T] {
+// Ptr returns a PtrCursor for the slice pointed to by `slice`, or nil if the pointer is nil
+//
+// The cursor always reads the current value of the slice, so it follows any changes made
+// to it. Changes made by something other than the cursor are reported by Changed, and
+// the cursor's position can be repaired with the WithResizePolicy option
+func Ptr[T any](slice *[]T, opts ...Option) PtrCursor[T] {
 	if slice == nil {
 		return nil
 	}
+	cfg := newConfig(opts...)
 	return &ptrCursor[T]{
-		slice: slice,
+		slice:    slice,
+		strict:   cfg.strict,
+		marks:    marks{},
+		seen:     snap(*slice),
+		policy:   cfg.resize,
+		onResize: cfg.onResize,
 	}
 }
 
 // Cur returns the same indexed item in the slice
 func (c *ptrCursor[T]) Cur() T {
-	if c.slice == nil || c.pos >= len(*c.slice) {
-		var zero T
-		return zero
-	}
-	s := *c.slice
-	return s[c.pos]
+	v, _ := c.CurOK()
+	return v
 }
 
 // Pos returns the current position in the cursor
 func (c *ptrCursor[T]) Pos() int {
+	c.watch()
 	if c.slice == nil || c.pos >= len(*c.slice) {
 		return -1
 	}
@@ -35,6 +54,7 @@ func (c *ptrCursor[T]) Pos() int {
 
 // Len returns the total size of the underlying slice
 func (c *ptrCursor[T]) Len() int {
+	c.watch()
 	if c.slice == nil {
 		return -1
 	}
@@ -43,43 +63,30 @@ func (c *ptrCursor[T]) Len() int {
 
 // Next returns the next item in the slice, or the zero-value for T as EOF
 func (c *ptrCursor[T]) Next() T {
-	if c.slice == nil || c.pos >= len(*c.slice) {
-		var zero T
-		return zero
-	}
-	c.pos++
-	s := *c.slice
-	return s[c.pos-1]
+	v, _ := c.NextOK()
+	return v
 }
 
 // Prev returns the previous item in the slice, or the zero-value for T as EOF if
 // index is / would be less than zero
 func (c *ptrCursor[T]) Prev() T {
-	if c.slice == nil || c.pos <= 0 {
-		var zero T
-		return zero
-	}
-	c.pos--
-	s := *c.slice
-	return s[c.pos]
+	v, _ := c.PrevOK()
+	return v
 }
 
 // Peek returns the next indexed item without advancing the cursor
 //
 // If the next token overflows the slice, returns the zero-value for T as EOF
 func (c *ptrCursor[T]) Peek() T {
-	if c.slice == nil || c.pos+1 >= len(*c.slice) {
-		var eof T
-		return eof
-	}
-	s := *c.slice
-	return s[c.pos+1]
+	v, _ := c.PeekOK()
+	return v
 }
 
 // Head returns to the beginning of the slice
 func (c *ptrCursor[T]) Head() T {
+	c.watch()
 	if c.slice == nil || len(*c.slice) == 0 {
-		var zero T
+		zero, _ := c.at("Head", 0)
 		return zero
 	}
 	c.pos = 0
@@ -88,8 +95,9 @@ func (c *ptrCursor[T]) Head() T {
 
 // Tail jumps to the end of the slice
 func (c *ptrCursor[T]) Tail() T {
+	c.watch()
 	if c.slice == nil || len(*c.slice) == 0 {
-		var zero T
+		zero, _ := c.at("Tail", -1)
 		return zero
 	}
 	c.pos = len(*c.slice)
@@ -101,14 +109,8 @@ func (c *ptrCursor[T]) Tail() T {
 // If the input index is below 0, the zero-value for T as EOF
 // If the input index is greater than the size of the slice, the zero-value for T as EOF
 func (c *ptrCursor[T]) Idx(idx int) T {
-	if c.slice == nil || idx < 0 || idx >= len(*c.slice) {
-		var zero T
-		return zero
-	}
-
-	c.pos = idx
-	s := *c.slice
-	return s[idx]
+	v, _ := c.IdxOK(idx)
+	return v
 }
 
 // Offset advances or rewinds `amount` steps in the slice, be it a positive or negative
@@ -117,14 +119,8 @@ func (c *ptrCursor[T]) Idx(idx int) T {
 // If the result offset is below 0, the zero-value for T as EOF
 // If the result offset is greater than the size of the slice, the zero-value for T as EOF
 func (c *ptrCursor[T]) Offset(amount int) T {
-	if c.slice == nil || c.pos+amount < 0 || c.pos+amount >= len(*c.slice) {
-		var zero T
-		return zero
-	}
-
-	c.pos += amount
-	s := *c.slice
-	return s[c.pos]
+	v, _ := c.OffsetOK(amount)
+	return v
 }
 
 // PeekIdx returns the next indexed item without advancing the cursor,
@@ -133,13 +129,8 @@ func (c *ptrCursor[T]) Offset(amount int) T {
 // If the input index is below 0, the zero-value for T as EOF
 // If the input index is greater than the size of the slice, the zero-value for T as EOF
 func (c *ptrCursor[T]) PeekIdx(idx int) T {
-	if c.slice == nil || idx < 0 || idx >= len(*c.slice)-1 {
-		var zero T
-		return zero
-	}
-
-	s := *c.slice
-	return s[idx]
+	v, _ := c.PeekIdxOK(idx)
+	return v
 }
 
 // PeekOffset returns the next indexed item without advancing the cursor,
@@ -148,13 +139,8 @@ func (c *ptrCursor[T]) PeekIdx(idx int) T {
 // If the result offset is below 0, the zero-value for T as EOF
 // If the result offset is greater than the size of the slice, the zero-value for T as EOF
 func (c *ptrCursor[T]) PeekOffset(amount int) T {
-	if c.slice == nil || c.pos+amount < 0 || c.pos+amount >= len(*c.slice)-1 {
-		var zero T
-		return zero
-	}
-
-	s := *c.slice
-	return s[c.pos+amount]
+	v, _ := c.PeekOffsetOK(amount)
+	return v
 }
 
 // Extract returns a slice from index `start` to index `end`
@@ -162,6 +148,7 @@ func (c *ptrCursor[T]) Extract(start, end int) []T {
 	if c.slice == nil {
 		return nil
 	}
+	c.watch()
 	if start < 0 {
 		start = 0
 	}
@@ -175,3 +162,192 @@ func (c *ptrCursor[T]) Extract(start, end int) []T {
 	s := *c.slice
 	return s[start:end]
 }
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *ptrCursor[T]) Clone() Cursor[T] {
+	return &ptrCursor[T]{
+		slice:    c.slice,
+		pos:      c.pos,
+		strict:   c.strict,
+		marks:    c.marks,
+		seen:     c.seen,
+		policy:   c.policy,
+		onResize: c.onResize,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *ptrCursor[T]) Mark() Mark {
+	c.watch()
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *ptrCursor[T]) Reset(m Mark) {
+	c.pos = clamp(int(m), c.Len())
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *ptrCursor[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *ptrCursor[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *ptrCursor[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the slice, moving the
+// cursor to its head and walking forward until the end of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ptrCursor[T]) All() iter.Seq2[int, T] {
+	return all[T](c)
+}
+
+// Forward returns an iterator over the index and item pairs in the slice, walking
+// forward from the cursor's current position until the end of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ptrCursor[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](c)
+}
+
+// Backward returns an iterator over the index and item pairs in the slice, walking
+// backwards from the cursor's current position until the head of the slice
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ptrCursor[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](c)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the slice
+func (c *ptrCursor[T]) CurOK() (T, bool) {
+	c.watch()
+	return c.at("Cur", c.pos)
+}
+
+// NextOK advances the cursor, returning the next item in the slice and whether
+// it was within the bounds of the slice
+func (c *ptrCursor[T]) NextOK() (T, bool) {
+	c.watch()
+	v, ok := c.at("Next", c.pos)
+	if ok {
+		c.pos++
+	}
+	return v, ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the slice and whether
+// it was within the bounds of the slice
+func (c *ptrCursor[T]) PrevOK() (T, bool) {
+	c.watch()
+	v, ok := c.at("Prev", c.pos-1)
+	if ok {
+		c.pos--
+	}
+	return v, ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the slice
+func (c *ptrCursor[T]) PeekOK() (T, bool) {
+	c.watch()
+	return c.at("Peek", c.pos+1)
+}
+
+// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+// whether `idx` was within the bounds of the slice
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *ptrCursor[T]) IdxOK(idx int) (T, bool) {
+	c.watch()
+	return c.jump("Idx", idx)
+}
+
+// OffsetOK advances or rewinds `amount` steps in the slice, returning the item and
+// whether the result offset was within the bounds of the slice
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *ptrCursor[T]) OffsetOK(amount int) (T, bool) {
+	c.watch()
+	return c.jump("Offset", c.pos+amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the slice
+func (c *ptrCursor[T]) PeekIdxOK(idx int) (T, bool) {
+	c.watch()
+	return c.at("PeekIdx", idx)
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the slice
+func (c *ptrCursor[T]) PeekOffsetOK(amount int) (T, bool) {
+	c.watch()
+	return c.at("PeekOffset", c.pos+amount)
+}
+
+// Err returns the *BoundsError raised by the last navigation call, if the cursor
+// was created with the WithStrict option
+//
+// Under the ErrorOnResize policy, a *ResizeError takes precedence until the change is
+// acknowledged with Changed
+func (c *ptrCursor[T]) Err() error {
+	if c.resized != nil {
+		return c.resized
+	}
+	return c.err
+}
+
+// mutatesOnRead returns whether the read-only methods record errors or watch the slice
+// for changes
+func (c *ptrCursor[T]) mutatesOnRead() bool {
+	return c.strict || c.policy != KeepOnResize || c.onResize != nil
+}
+
+// at returns the item in index `idx` and whether it is within the bounds of the slice,
+// recording a *BoundsError for the operation `op` on strict cursors
+func (c *ptrCursor[T]) at(op string, idx int) (T, bool) {
+	if c.slice == nil || idx < 0 || idx >= len(*c.slice) {
+		if c.strict {
+			c.err = &BoundsError{Op: op, Index: idx, Len: c.Len()}
+		}
+		var zero T
+		return zero, false
+	}
+	if c.strict {
+		c.err = nil
+	}
+	s := *c.slice
+	return s[idx], true
+}
+
+// jump moves the cursor to index `idx` if it is within the bounds of the slice
+func (c *ptrCursor[T]) jump(op string, idx int) (T, bool) {
+	v, ok := c.at(op, idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
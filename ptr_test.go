@@ -151,6 +151,16 @@ func TestPointer(t *testing.T) {
 				t.Errorf("unexpected value: wanted %d ; got %d", input[2], v)
 			}
 		})
+		t.Run("Last", func(t *testing.T) {
+			v := c.PeekIdx(10)
+			if v != input[10] {
+				t.Errorf("unexpected value: wanted %d ; got %d", input[10], v)
+			}
+			v = c.PeekIdx(11)
+			if v != eof {
+				t.Errorf("unexpected value: wanted %d ; got %d", eof, v)
+			}
+		})
 		t.Run("HitTail", func(t *testing.T) {
 			v := c.PeekIdx(20)
 			cur := c.Cur()
@@ -201,6 +211,16 @@ func TestPointer(t *testing.T) {
 				t.Errorf("unexpected value: wanted %d ; got %d", input[2], v)
 			}
 		})
+		t.Run("Last", func(t *testing.T) {
+			v := c.PeekOffset(8)
+			if v != input[10] {
+				t.Errorf("unexpected value: wanted %d ; got %d", input[10], v)
+			}
+			v = c.PeekOffset(9)
+			if v != eof {
+				t.Errorf("unexpected value: wanted %d ; got %d", eof, v)
+			}
+		})
 		t.Run("HitTail", func(t *testing.T) {
 			v := c.PeekOffset(20)
 			cur := c.Cur()
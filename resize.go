@@ -0,0 +1,90 @@
+package cur
+
+// ResizePolicy defines how a Ptr cursor repairs its position when the slice it points
+// to is changed by something other than the cursor itself
+type ResizePolicy uint8
+
+const (
+	// KeepOnResize leaves the cursor's position untouched. This is the default policy
+	KeepOnResize ResizePolicy = iota
+	// ClampOnResize moves the cursor to the tail of the slice if its position is no
+	// longer within it
+	ClampOnResize
+	// ResetOnResize moves the cursor to the head of the slice
+	ResetOnResize
+	// ErrorOnResize leaves the cursor's position untouched and records a *ResizeError,
+	// which can be retrieved with Err until the change is acknowledged with Changed
+	ErrorOnResize
+)
+
+// snapshot describes the length and backing array of a slice, as last seen by a cursor
+type snapshot struct {
+	len  int
+	data any
+}
+
+// snap returns the snapshot for the slice `s`
+func snap[T any](s []T) snapshot {
+	if cap(s) == 0 {
+		return snapshot{}
+	}
+	return snapshot{len: len(s), data: &s[:1][0]}
+}
+
+// Changed reports whether the slice was changed by something other than the cursor,
+// either resized or replaced with a different one, since the last call to Changed.
+// It also clears any *ResizeError recorded under the ErrorOnResize policy
+//
+// Edits made with Splice are not reported as changes
+func (c *ptrCursor[T]) Changed() bool {
+	c.sync()
+	changed := c.changed
+	c.changed = false
+	c.resized = nil
+	return changed
+}
+
+// watch detects changes in the slice on every access, if the cursor was created with
+// a resize policy or hook
+func (c *ptrCursor[T]) watch() {
+	if c.policy != KeepOnResize || c.onResize != nil {
+		c.sync()
+	}
+}
+
+// sync compares the slice with the last seen snapshot, applying the resize policy and
+// calling the resize hook if it changed
+//
+// A change is invalidating if the slice shrank below the cursor's position, or if its
+// backing array was replaced without the slice growing, as appending to the slice
+// may also move it to a new array
+func (c *ptrCursor[T]) sync() {
+	if c.slice == nil {
+		return
+	}
+	cur := snap(*c.slice)
+	if cur == c.seen {
+		return
+	}
+	prev := c.seen
+	c.seen = cur
+	c.changed = true
+
+	replaced := cur.data != prev.data && cur.len <= prev.len
+	if replaced || (cur.len < prev.len && c.pos >= cur.len) {
+		switch c.policy {
+		case ClampOnResize:
+			if c.pos >= cur.len {
+				c.pos = max(cur.len-1, 0)
+			}
+		case ResetOnResize:
+			c.pos = 0
+		case ErrorOnResize:
+			c.resized = &ResizeError{Pos: c.pos, OldLen: prev.len, NewLen: cur.len, Replaced: replaced}
+		}
+	}
+
+	if c.onResize != nil {
+		c.onResize(prev.len, cur.len)
+	}
+}
@@ -0,0 +1,151 @@
+package cur
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResize(t *testing.T) {
+	t.Run("Changed", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		c := Ptr(&s)
+		if c.Changed() {
+			t.Errorf("expected no changes")
+		}
+
+		s = append(s, 4)
+		if !c.Changed() {
+			t.Errorf("expected a change")
+		}
+		if c.Changed() {
+			t.Errorf("expected the change to be acknowledged")
+		}
+
+		c.Splice(0, 1, 9, 9)
+		if c.Changed() {
+			t.Errorf("expected edits through the cursor not to be reported")
+		}
+
+		s[0] = 5
+		if c.Changed() {
+			t.Errorf("expected in-place writes not to be reported")
+		}
+
+		s = []int{7, 8, 9, 10, 11}
+		if !c.Changed() {
+			t.Errorf("expected a change")
+		}
+	})
+
+	t.Run("Keep", func(t *testing.T) {
+		s := []int{1, 2, 3, 4}
+		c := Ptr(&s)
+		c.Idx(3)
+
+		s = s[:2]
+		if c.Pos() != -1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+		}
+	})
+
+	t.Run("Clamp", func(t *testing.T) {
+		s := []int{1, 2, 3, 4}
+		c := Ptr(&s, WithResizePolicy(ClampOnResize))
+		c.Idx(3)
+
+		s = s[:2]
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+		if v := c.Cur(); v != 2 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 2, v)
+		}
+
+		c.Idx(0)
+		s = []int{5, 6}
+		if v := c.Cur(); v != 5 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 5, v)
+		}
+
+		s = s[:0]
+		if c.Pos() != -1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+		}
+		s = append(s, 1)
+		if v := c.Cur(); v != 1 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 1, v)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		s := []int{1, 2, 3, 4}
+		c := Ptr(&s, WithResizePolicy(ResetOnResize))
+		c.Idx(2)
+
+		s = append(s, 5)
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+
+		s = []int{6, 7, 8}
+		if v := c.Next(); v != 6 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 6, v)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		s := []int{1, 2, 3, 4}
+		c := Ptr(&s, WithResizePolicy(ErrorOnResize))
+		c.Idx(3)
+
+		s = s[:2]
+		if _, ok := c.NextOK(); ok {
+			t.Errorf("expected EOF")
+		}
+
+		var target *ResizeError
+		err := Err[int](c)
+		if !errors.Is(err, ErrResized) || !errors.As(err, &target) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if target.Pos != 3 || target.OldLen != 4 || target.NewLen != 2 || target.Replaced {
+			t.Errorf("unexpected error: %+v", target)
+		}
+
+		c.Head()
+		if Err[int](c) == nil {
+			t.Errorf("expected the error to persist until acknowledged")
+		}
+		if !c.Changed() {
+			t.Errorf("expected a change")
+		}
+		if err := Err[int](c); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Hook", func(t *testing.T) {
+		var calls [][2]int
+		s := []int{1, 2, 3}
+		c := Ptr(&s, WithResizeHook(func(oldLen, newLen int) {
+			calls = append(calls, [2]int{oldLen, newLen})
+		}))
+
+		s = append(s, 4)
+		c.Next()
+		c.Splice(0, 1)
+		s = s[:1]
+		c.Cur()
+		c.Cur()
+
+		wants := [][2]int{{3, 4}, {3, 1}}
+		if len(calls) != len(wants) {
+			t.Fatalf("unexpected calls: wanted %v ; got %v", wants, calls)
+		}
+		for i := range wants {
+			if calls[i] != wants[i] {
+				t.Errorf("unexpected call: wanted %v ; got %v", wants[i], calls[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package cur
+
+import "iter"
+
+type ring[T any] struct {
+	slice []T
+	pos   int
+	tx    txStack
+}
+
+// Ring returns a circular Cursor for the input slice, or nil if the slice is empty
+//
+// Its Next, Prev, Peek, Offset and PeekOffset methods wrap around the slice instead of
+// returning EOF, while Idx and PeekIdx still only accept indices within its bounds
+func Ring[T any](slice []T) Cursor[T] {
+	if len(slice) == 0 {
+		return nil
+	}
+	return &ring[T]{
+		slice: slice,
+	}
+}
+
+// wraps returns true, as the cursor wraps around the slice
+func (c *ring[T]) wraps() bool {
+	return true
+}
+
+// wrap returns the index `idx` modulo the length of the slice
+func (c *ring[T]) wrap(idx int) int {
+	idx %= len(c.slice)
+	if idx < 0 {
+		idx += len(c.slice)
+	}
+	return idx
+}
+
+// Cur returns the item in the current position
+func (c *ring[T]) Cur() T {
+	return c.slice[c.pos]
+}
+
+// Pos returns the current position in the cursor
+func (c *ring[T]) Pos() int {
+	return c.pos
+}
+
+// Len returns the total size of the underlying slice
+func (c *ring[T]) Len() int {
+	return len(c.slice)
+}
+
+// Next advances the cursor, returning the current item in the slice and
+// wrapping around to its head after the last item
+func (c *ring[T]) Next() T {
+	v, _ := c.NextOK()
+	return v
+}
+
+// Prev rewinds the cursor, returning the previous item in the slice and
+// wrapping around to its tail before the first item
+func (c *ring[T]) Prev() T {
+	v, _ := c.PrevOK()
+	return v
+}
+
+// Peek returns the next indexed item without advancing the cursor, wrapping
+// around to the head of the slice
+func (c *ring[T]) Peek() T {
+	v, _ := c.PeekOK()
+	return v
+}
+
+// Head returns to the beginning of the slice
+func (c *ring[T]) Head() T {
+	c.pos = 0
+	return c.Next()
+}
+
+// Tail jumps to the end of the slice
+func (c *ring[T]) Tail() T {
+	c.pos = 0
+	return c.Prev()
+}
+
+// Idx jumps to the specific index `idx` in the slice
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the slice, the zero-value for T as EOF
+func (c *ring[T]) Idx(idx int) T {
+	v, _ := c.IdxOK(idx)
+	return v
+}
+
+// Offset advances or rewinds `amount` steps in the slice, be it a positive or negative
+// input, wrapping around its bounds
+func (c *ring[T]) Offset(amount int) T {
+	v, _ := c.OffsetOK(amount)
+	return v
+}
+
+// PeekIdx returns the indexed item without moving the cursor, with the index `idx`
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the slice, the zero-value for T as EOF
+func (c *ring[T]) PeekIdx(idx int) T {
+	v, _ := c.PeekIdxOK(idx)
+	return v
+}
+
+// PeekOffset returns the item `amount` steps away without moving the cursor, wrapping
+// around the bounds of the slice
+func (c *ring[T]) PeekOffset(amount int) T {
+	v, _ := c.PeekOffsetOK(amount)
+	return v
+}
+
+// Extract returns a slice from index `start` to index `end`
+func (c *ring[T]) Extract(start, end int) []T {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(c.slice) {
+		end = len(c.slice)
+	}
+	for start > end {
+		start--
+	}
+
+	return c.slice[start:end]
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *ring[T]) Clone() Cursor[T] {
+	return &ring[T]{
+		slice: c.slice,
+		pos:   c.pos,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *ring[T]) Mark() Mark {
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *ring[T]) Reset(m Mark) {
+	c.pos = c.wrap(int(m))
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *ring[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *ring[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *ring[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the slice, moving the
+// cursor to its head and walking forward for one lap
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ring[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.pos = 0
+		c.Forward()(yield)
+	}
+}
+
+// Forward returns an iterator over the index and item pairs in the slice, walking
+// forward from the cursor's current position for one lap
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ring[T]) Forward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for range len(c.slice) {
+			if !yield(c.pos, c.slice[c.pos]) {
+				return
+			}
+			c.pos = c.wrap(c.pos + 1)
+		}
+	}
+}
+
+// Backward returns an iterator over the index and item pairs in the slice, walking
+// backwards from the cursor's current position for one lap
+//
+// The cursor is left on the item where the iteration stopped
+func (c *ring[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for range len(c.slice) {
+			if !yield(c.pos, c.slice[c.pos]) {
+				return
+			}
+			c.pos = c.wrap(c.pos - 1)
+		}
+	}
+}
+
+// CurOK returns the item in the current position, which is always within the
+// bounds of the slice
+func (c *ring[T]) CurOK() (T, bool) {
+	return c.slice[c.pos], true
+}
+
+// NextOK advances the cursor, returning the current item in the slice and
+// wrapping around to its head after the last item
+func (c *ring[T]) NextOK() (T, bool) {
+	v := c.slice[c.pos]
+	c.pos = c.wrap(c.pos + 1)
+	return v, true
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the slice and
+// wrapping around to its tail before the first item
+func (c *ring[T]) PrevOK() (T, bool) {
+	c.pos = c.wrap(c.pos - 1)
+	return c.slice[c.pos], true
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, wrapping
+// around to the head of the slice
+func (c *ring[T]) PeekOK() (T, bool) {
+	return c.slice[c.wrap(c.pos+1)], true
+}
+
+// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+// whether `idx` was within the bounds of the slice
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *ring[T]) IdxOK(idx int) (T, bool) {
+	v, ok := c.PeekIdxOK(idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
+
+// OffsetOK advances or rewinds `amount` steps in the slice, wrapping around its bounds
+func (c *ring[T]) OffsetOK(amount int) (T, bool) {
+	c.pos = c.wrap(c.pos + amount)
+	return c.slice[c.pos], true
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the slice
+func (c *ring[T]) PeekIdxOK(idx int) (T, bool) {
+	if idx < 0 || idx >= len(c.slice) {
+		var eof T
+		return eof, false
+	}
+	return c.slice[idx], true
+}
+
+// PeekOffsetOK returns the item `amount` steps away without moving the cursor, wrapping
+// around the bounds of the slice
+func (c *ring[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.slice[c.wrap(c.pos+amount)], true
+}
@@ -0,0 +1,110 @@
+package cur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRing(t *testing.T) {
+	data := []int{1, 11, 21}
+
+	t.Run("New", func(t *testing.T) {
+		if Ring([]int{}) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("Next", func(t *testing.T) {
+		c := Ring(data)
+		for i := range 7 {
+			if v := c.Next(); v != data[i%len(data)] {
+				t.Errorf("unexpected value: wanted %d ; got %d", data[i%len(data)], v)
+			}
+		}
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+	})
+	t.Run("Prev", func(t *testing.T) {
+		c := Ring(data)
+		if v := c.Prev(); v != data[2] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[2], v)
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+	})
+	t.Run("Peek", func(t *testing.T) {
+		c := Ring(data)
+		c.Idx(2)
+		if v := c.Peek(); v != data[0] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[0], v)
+		}
+		if v := c.PeekOffset(-4); v != data[1] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[1], v)
+		}
+		if v := c.PeekIdx(3); v != eof {
+			t.Errorf("unexpected value: wanted %d ; got %d", eof, v)
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+	})
+	t.Run("Offset", func(t *testing.T) {
+		c := Ring(data)
+		if v := c.Offset(5); v != data[2] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[2], v)
+		}
+		if v := c.Offset(-8); v != data[0] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[0], v)
+		}
+	})
+	t.Run("HeadTail", func(t *testing.T) {
+		c := Ring(data)
+		if v := c.Tail(); v != data[2] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[2], v)
+		}
+		if v := c.Head(); v != data[0] {
+			t.Errorf("unexpected value: wanted %d ; got %d", data[0], v)
+		}
+	})
+	t.Run("Checked", func(t *testing.T) {
+		c := Check(Ring(data))
+		c.Idx(2)
+		if v, ok := c.NextOK(); !ok || v != data[2] {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", data[2], v, ok)
+		}
+		if _, ok := c.IdxOK(-1); ok {
+			t.Errorf("expected EOF")
+		}
+	})
+	t.Run("Forward", func(t *testing.T) {
+		c := Ring(data)
+		c.Idx(1)
+		var got []int
+		for _, v := range c.Forward() {
+			got = append(got, v)
+		}
+		if want := []int{11, 21, 1}; !slices.Equal(got, want) {
+			t.Errorf("unexpected output: wanted %v ; got %v", want, got)
+		}
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+	})
+	t.Run("Backward", func(t *testing.T) {
+		c := Ring(data)
+		var got []int
+		for i := range c.Backward() {
+			got = append(got, i)
+			if i == 1 {
+				break
+			}
+		}
+		if want := []int{0, 2, 1}; !slices.Equal(got, want) {
+			t.Errorf("unexpected output: wanted %v ; got %v", want, got)
+		}
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+	})
+}
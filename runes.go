@@ -0,0 +1,401 @@
+package cur
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// RuneCursor is a Cursor over the runes in a UTF-8 encoded string or byte slice, that
+// decodes the input in place instead of converting it to a []rune
+//
+// Its positions are rune indices, while ByteOffset reports where the current rune is
+// in the original input
+type RuneCursor interface {
+	CheckedCursor[rune]
+
+	// ByteOffset returns the byte offset of the current rune in the input, or the
+	// length of the input when the cursor is past its last rune
+	ByteOffset() int
+
+	// ExtractString returns the input from rune index `start` to rune index `end`
+	ExtractString(start, end int) string
+}
+
+type runeCursor struct {
+	str   string
+	bytes []byte
+	size  int
+	len   int
+	ascii bool
+
+	pos int
+	off int
+	tx  txStack
+}
+
+// Runes returns a RuneCursor for the input string, or nil if the string is empty
+func Runes(s string) RuneCursor {
+	if len(s) == 0 {
+		return nil
+	}
+	return newRuneCursor(s, nil)
+}
+
+// RunesBytes returns a RuneCursor for the input byte slice, or nil if the slice is empty
+func RunesBytes(b []byte) RuneCursor {
+	if len(b) == 0 {
+		return nil
+	}
+	return newRuneCursor("", b)
+}
+
+func newRuneCursor(s string, b []byte) *runeCursor {
+	c := &runeCursor{
+		str:   s,
+		bytes: b,
+	}
+	if b != nil {
+		c.size = len(b)
+		c.len = utf8.RuneCount(b)
+	} else {
+		c.size = len(s)
+		c.len = utf8.RuneCountInString(s)
+	}
+	c.ascii = c.len == c.size
+	return c
+}
+
+// decode returns the rune starting at byte offset `off`, and its width
+func (c *runeCursor) decode(off int) (rune, int) {
+	if c.bytes != nil {
+		return utf8.DecodeRune(c.bytes[off:])
+	}
+	return utf8.DecodeRuneInString(c.str[off:])
+}
+
+// decodeLast returns the rune ending at byte offset `off`, and its width
+func (c *runeCursor) decodeLast(off int) (rune, int) {
+	if c.bytes != nil {
+		return utf8.DecodeLastRune(c.bytes[:off])
+	}
+	return utf8.DecodeLastRuneInString(c.str[:off])
+}
+
+func (c *runeCursor) substr(start, end int) string {
+	if c.bytes != nil {
+		return string(c.bytes[start:end])
+	}
+	return c.str[start:end]
+}
+
+// locate returns the byte offset of the rune index `idx`, in the range [0, Len], walking
+// from the closest known position: the head, the tail or the cursor itself
+func (c *runeCursor) locate(idx int) int {
+	if c.ascii {
+		return idx
+	}
+
+	pos, off, dist := 0, 0, idx
+	if d := abs(idx - c.pos); d < dist {
+		pos, off, dist = c.pos, c.off, d
+	}
+	if c.len-idx < dist {
+		pos, off = c.len, c.size
+	}
+
+	for ; pos < idx; pos++ {
+		_, w := c.decode(off)
+		off += w
+	}
+	for ; pos > idx; pos-- {
+		_, w := c.decodeLast(off)
+		off -= w
+	}
+	return off
+}
+
+// seek moves the cursor to rune index `idx`, in the range [0, Len]
+func (c *runeCursor) seek(idx int) {
+	c.off = c.locate(idx)
+	c.pos = idx
+}
+
+// Cur returns the rune in the current position
+func (c *runeCursor) Cur() rune {
+	v, _ := c.CurOK()
+	return v
+}
+
+// Pos returns the current rune index in the cursor
+func (c *runeCursor) Pos() int {
+	if c.pos >= c.len {
+		return -1
+	}
+	return c.pos
+}
+
+// Len returns the total number of runes in the input
+func (c *runeCursor) Len() int {
+	return c.len
+}
+
+// Next advances the cursor, returning the next rune in the input, or zero as EOF
+func (c *runeCursor) Next() rune {
+	v, _ := c.NextOK()
+	return v
+}
+
+// Prev returns the previous rune in the input, or zero as EOF if
+// index is / would be less than zero
+func (c *runeCursor) Prev() rune {
+	v, _ := c.PrevOK()
+	return v
+}
+
+// Peek returns the next indexed rune without advancing the cursor
+//
+// If the next rune overflows the input, returns zero as EOF
+func (c *runeCursor) Peek() rune {
+	v, _ := c.PeekOK()
+	return v
+}
+
+// Head returns to the beginning of the input
+func (c *runeCursor) Head() rune {
+	c.pos, c.off = 0, 0
+	return c.Next()
+}
+
+// Tail jumps to the end of the input
+func (c *runeCursor) Tail() rune {
+	c.pos, c.off = c.len, c.size
+	return c.Prev()
+}
+
+// Idx jumps to the specific rune index `idx` in the input
+//
+// If the input index is below 0, zero as EOF
+// If the input index is greater than the number of runes, zero as EOF
+func (c *runeCursor) Idx(idx int) rune {
+	v, _ := c.IdxOK(idx)
+	return v
+}
+
+// Offset advances or rewinds `amount` runes in the input, be it a positive or negative
+// input.
+//
+// If the result offset is below 0, zero as EOF
+// If the result offset is greater than the number of runes, zero as EOF
+func (c *runeCursor) Offset(amount int) rune {
+	v, _ := c.OffsetOK(amount)
+	return v
+}
+
+// PeekIdx returns the rune in index `idx` without moving the cursor
+//
+// If the input index is below 0, zero as EOF
+// If the input index is greater than the number of runes, zero as EOF
+func (c *runeCursor) PeekIdx(idx int) rune {
+	v, _ := c.PeekIdxOK(idx)
+	return v
+}
+
+// PeekOffset returns the rune `amount` steps away without moving the cursor
+//
+// If the result offset is below 0, zero as EOF
+// If the result offset is greater than the number of runes, zero as EOF
+func (c *runeCursor) PeekOffset(amount int) rune {
+	v, _ := c.PeekOffsetOK(amount)
+	return v
+}
+
+// Extract returns the runes from index `start` to index `end`
+//
+// As the input is not stored as a []rune, the returned slice is a copy
+func (c *runeCursor) Extract(start, end int) []rune {
+	return []rune(c.ExtractString(start, end))
+}
+
+// ExtractString returns the input from rune index `start` to rune index `end`
+func (c *runeCursor) ExtractString(start, end int) string {
+	if start < 0 {
+		start = 0
+	}
+	if end > c.len {
+		end = c.len
+	}
+	for start > end {
+		start--
+	}
+
+	return c.substr(c.locate(start), c.locate(end))
+}
+
+// ByteOffset returns the byte offset of the current rune in the input, or the
+// length of the input when the cursor is past its last rune
+func (c *runeCursor) ByteOffset() int {
+	return c.off
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *runeCursor) Clone() Cursor[rune] {
+	return c.clone()
+}
+
+func (c *runeCursor) clone() *runeCursor {
+	clone := *c
+	clone.tx = nil
+	return &clone
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *runeCursor) Mark() Mark {
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *runeCursor) Reset(m Mark) {
+	c.seek(clamp(int(m), c.len))
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *runeCursor) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *runeCursor) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *runeCursor) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and rune pairs in the input, moving the
+// cursor to its head and walking forward until the end of the input
+//
+// The cursor is left on the rune where the iteration stopped
+func (c *runeCursor) All() iter.Seq2[int, rune] {
+	return all[rune](c)
+}
+
+// Forward returns an iterator over the index and rune pairs in the input, walking
+// forward from the cursor's current position until the end of the input
+//
+// The cursor is left on the rune where the iteration stopped
+func (c *runeCursor) Forward() iter.Seq2[int, rune] {
+	return forward[rune](c)
+}
+
+// Backward returns an iterator over the index and rune pairs in the input, walking
+// backwards from the cursor's current position until the head of the input
+//
+// The cursor is left on the rune where the iteration stopped
+func (c *runeCursor) Backward() iter.Seq2[int, rune] {
+	return backward[rune](c)
+}
+
+// CurOK returns the rune in the current position, and whether the cursor
+// is within the bounds of the input
+func (c *runeCursor) CurOK() (rune, bool) {
+	if c.pos < 0 || c.pos >= c.len {
+		return 0, false
+	}
+	r, _ := c.decode(c.off)
+	return r, true
+}
+
+// NextOK advances the cursor, returning the next rune in the input and whether
+// it was within the bounds of the input
+func (c *runeCursor) NextOK() (rune, bool) {
+	if c.pos >= c.len {
+		return 0, false
+	}
+	r, w := c.decode(c.off)
+	c.pos++
+	c.off += w
+	return r, true
+}
+
+// PrevOK rewinds the cursor, returning the previous rune in the input and whether
+// it was within the bounds of the input
+func (c *runeCursor) PrevOK() (rune, bool) {
+	if c.pos <= 0 {
+		return 0, false
+	}
+	r, w := c.decodeLast(c.off)
+	c.pos--
+	c.off -= w
+	return r, true
+}
+
+// PeekOK returns the next indexed rune without advancing the cursor, and whether
+// it was within the bounds of the input
+func (c *runeCursor) PeekOK() (rune, bool) {
+	if c.pos+1 >= c.len {
+		return 0, false
+	}
+	_, w := c.decode(c.off)
+	r, _ := c.decode(c.off + w)
+	return r, true
+}
+
+// IdxOK jumps to the specific rune index `idx` in the input, returning the rune and
+// whether `idx` was within the bounds of the input
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *runeCursor) IdxOK(idx int) (rune, bool) {
+	if idx < 0 || idx >= c.len {
+		return 0, false
+	}
+	c.seek(idx)
+	r, _ := c.decode(c.off)
+	return r, true
+}
+
+// OffsetOK advances or rewinds `amount` runes in the input, returning the rune and
+// whether the result offset was within the bounds of the input
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *runeCursor) OffsetOK(amount int) (rune, bool) {
+	return c.IdxOK(c.pos + amount)
+}
+
+// PeekIdxOK returns the rune in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the input
+func (c *runeCursor) PeekIdxOK(idx int) (rune, bool) {
+	if idx < 0 || idx >= c.len {
+		return 0, false
+	}
+	r, _ := c.decode(c.locate(idx))
+	return r, true
+}
+
+// PeekOffsetOK returns the rune `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the input
+func (c *runeCursor) PeekOffsetOK(amount int) (rune, bool) {
+	return c.PeekIdxOK(c.pos + amount)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,105 @@
+package cur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRunes(t *testing.T) {
+	const input = "héllo, 世界!"
+	runes := []rune(input)
+
+	for _, test := range []struct {
+		name string
+		c    func() RuneCursor
+	}{
+		{"String", func() RuneCursor { return Runes(input) }},
+		{"Bytes", func() RuneCursor { return RunesBytes([]byte(input)) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Next", func(t *testing.T) {
+				c := test.c()
+				if c.Len() != len(runes) {
+					t.Errorf("unexpected length: wanted %d ; got %d", len(runes), c.Len())
+				}
+				var got []rune
+				for r, ok := c.NextOK(); ok; r, ok = c.NextOK() {
+					got = append(got, r)
+				}
+				if !slices.Equal(got, runes) {
+					t.Errorf("unexpected output: wanted %q ; got %q", runes, got)
+				}
+				if c.ByteOffset() != len(input) {
+					t.Errorf("unexpected offset: wanted %d ; got %d", len(input), c.ByteOffset())
+				}
+			})
+			t.Run("Prev", func(t *testing.T) {
+				c := test.c()
+				if r := c.Tail(); r != '!' {
+					t.Errorf("unexpected value: wanted %q ; got %q", '!', r)
+				}
+				if r := c.Prev(); r != '界' {
+					t.Errorf("unexpected value: wanted %q ; got %q", '界', r)
+				}
+				if c.ByteOffset() != len("héllo, 世") {
+					t.Errorf("unexpected offset: wanted %d ; got %d", len("héllo, 世"), c.ByteOffset())
+				}
+			})
+			t.Run("Idx", func(t *testing.T) {
+				c := test.c()
+				for _, idx := range []int{7, 2, 9, 0, 8, 1} {
+					if r := c.Idx(idx); r != runes[idx] {
+						t.Errorf("unexpected value: wanted %q ; got %q", runes[idx], r)
+					}
+					if want := len(string(runes[:idx])); c.ByteOffset() != want {
+						t.Errorf("unexpected offset: wanted %d ; got %d", want, c.ByteOffset())
+					}
+				}
+				if r := c.Idx(len(runes)); r != 0 {
+					t.Errorf("unexpected value: wanted %q ; got %q", 0, r)
+				}
+			})
+			t.Run("Peek", func(t *testing.T) {
+				c := test.c()
+				c.Idx(6)
+				if r := c.Peek(); r != '世' {
+					t.Errorf("unexpected value: wanted %q ; got %q", '世', r)
+				}
+				if r := c.PeekOffset(-5); r != 'é' {
+					t.Errorf("unexpected value: wanted %q ; got %q", 'é', r)
+				}
+				if r := c.PeekIdx(9); r != '!' {
+					t.Errorf("unexpected value: wanted %q ; got %q", '!', r)
+				}
+				if c.Pos() != 6 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 6, c.Pos())
+				}
+			})
+			t.Run("Extract", func(t *testing.T) {
+				c := test.c()
+				if s := c.ExtractString(1, 9); s != "éllo, 世界" {
+					t.Errorf("unexpected value: wanted %q ; got %q", "éllo, 世界", s)
+				}
+				if r := c.Extract(7, 20); !slices.Equal(r, []rune("世界!")) {
+					t.Errorf("unexpected value: wanted %q ; got %q", "世界!", r)
+				}
+			})
+			t.Run("Reset", func(t *testing.T) {
+				c := test.c()
+				c.Idx(8)
+				m := c.Mark()
+				c.Head()
+				c.Reset(m)
+				if r := c.Cur(); r != '界' {
+					t.Errorf("unexpected value: wanted %q ; got %q", '界', r)
+				}
+			})
+		})
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		if Runes("") != nil || RunesBytes(nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+}
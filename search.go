@@ -0,0 +1,81 @@
+package cur
+
+// Seek moves the Cursor `c` to the first item matching `pred`, starting from its current
+// position, returning its index and value and whether it was found
+//
+// The cursor is not moved if no item matches `pred`
+func Seek[T any](c Cursor[T], pred func(T) bool) (int, T, bool) {
+	return find(c, int(c.Mark()), 1, pred, true)
+}
+
+// PeekFind returns the index and value of the first item matching `pred`, starting from
+// the current position of the Cursor `c`, and whether it was found, without moving it
+func PeekFind[T any](c Cursor[T], pred func(T) bool) (int, T, bool) {
+	return find(c, int(c.Mark()), 1, pred, false)
+}
+
+// FindNext moves the Cursor `c` to the next item matching `pred`, after its current
+// position, returning its index and value and whether it was found
+//
+// The cursor is not moved if no item matches `pred`
+func FindNext[T any](c Cursor[T], pred func(T) bool) (int, T, bool) {
+	return find(c, int(c.Mark())+1, 1, pred, true)
+}
+
+// FindPrev moves the Cursor `c` to the previous item matching `pred`, before its current
+// position, returning its index and value and whether it was found
+//
+// The cursor is not moved if no item matches `pred`
+func FindPrev[T any](c Cursor[T], pred func(T) bool) (int, T, bool) {
+	return find(c, int(c.Mark())-1, -1, pred, true)
+}
+
+// IndexOf returns the index of the first item in the Cursor `c` equal to `v`, or -1 if
+// there is none, without moving it
+//
+// On streaming cursors with a lookback window, the search starts from the oldest item
+// still kept
+func IndexOf[T comparable](c Cursor[T], v T) int {
+	var from int
+	if w, ok := c.(windowed); ok {
+		from = w.first()
+	}
+	idx, _, _ := find(c, from, 1, equal(v), false)
+	return idx
+}
+
+// LastIndexOf returns the index of the last item in the Cursor `c` equal to `v`, or -1
+// if there is none, without moving it
+//
+// On lazy and streaming cursors, only the items read so far and still kept are searched
+func LastIndexOf[T comparable](c Cursor[T], v T) int {
+	idx, _, _ := find(c, c.Len()-1, -1, equal(v), false)
+	return idx
+}
+
+// find walks the Cursor `c` from index `from` in steps of `step`, returning the first item
+// matching `pred` and moving the cursor to it if `move` is set
+func find[T any](c Cursor[T], from, step int, pred func(T) bool, move bool) (int, T, bool) {
+	cc := Check(c)
+	for i := from; i >= 0; i += step {
+		v, ok := cc.PeekIdxOK(i)
+		if !ok {
+			break
+		}
+		if pred(v) {
+			if move {
+				cc.IdxOK(i)
+			}
+			return i, v, true
+		}
+	}
+
+	var zero T
+	return -1, zero, false
+}
+
+func equal[T comparable](v T) func(T) bool {
+	return func(item T) bool {
+		return item == v
+	}
+}
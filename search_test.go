@@ -0,0 +1,139 @@
+package cur
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	items := []int{1, 4, 0, 4, 7, 0, 2}
+	isZero := func(v int) bool { return v == 0 }
+
+	for _, test := range []struct {
+		name string
+		c    func() Cursor[int]
+	}{
+		{"New", func() Cursor[int] { return New(items) }},
+		{"Ptr", func() Cursor[int] { return Ptr(&items) }},
+		{"Lazy", func() Cursor[int] { return LazySeq(slices.Values(items)) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Seek", func(t *testing.T) {
+				c := test.c()
+				c.Idx(2)
+				idx, v, ok := Seek(c, isZero)
+				if !ok || idx != 2 || v != 0 {
+					t.Errorf("unexpected match: wanted %d ; got %d (%v)", 2, idx, ok)
+				}
+				c.Next()
+				if idx, _, _ = Seek(c, isZero); idx != 5 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 5, idx)
+				}
+				if c.Pos() != 5 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 5, c.Pos())
+				}
+				c.Next()
+				if idx, _, ok = Seek(c, isZero); ok || idx != -1 {
+					t.Errorf("unexpected match: wanted %d ; got %d (%v)", -1, idx, ok)
+				}
+				if c.Pos() != 6 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 6, c.Pos())
+				}
+			})
+			t.Run("PeekFind", func(t *testing.T) {
+				c := test.c()
+				idx, v, ok := PeekFind(c, func(v int) bool { return v > 4 })
+				if !ok || idx != 4 || v != 7 {
+					t.Errorf("unexpected match: wanted %d ; got %d (%v)", 4, idx, ok)
+				}
+				if c.Pos() != 0 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+				}
+			})
+			t.Run("FindNext", func(t *testing.T) {
+				c := test.c()
+				c.Idx(2)
+				if idx, _, _ := FindNext(c, isZero); idx != 5 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 5, idx)
+				}
+				if _, _, ok := FindNext(c, isZero); ok {
+					t.Errorf("expected no match")
+				}
+				if c.Pos() != 5 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 5, c.Pos())
+				}
+			})
+			t.Run("FindPrev", func(t *testing.T) {
+				c := test.c()
+				c.Idx(5)
+				if idx, _, _ := FindPrev(c, isZero); idx != 2 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 2, idx)
+				}
+				if _, _, ok := FindPrev(c, isZero); ok {
+					t.Errorf("expected no match")
+				}
+				if c.Pos() != 2 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+				}
+
+				c.Idx(6)
+				c.Next()
+				if idx, _, _ := FindPrev(c, func(v int) bool { return v == 2 }); idx != 6 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 6, idx)
+				}
+			})
+			t.Run("IndexOf", func(t *testing.T) {
+				c := test.c()
+				c.Idx(3)
+				if idx := IndexOf(c, 4); idx != 1 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 1, idx)
+				}
+				if idx := IndexOf(c, 9); idx != -1 {
+					t.Errorf("unexpected index: wanted %d ; got %d", -1, idx)
+				}
+				if idx := LastIndexOf(c, 4); idx != 3 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 3, idx)
+				}
+				if idx := LastIndexOf(c, 1); idx != 0 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 0, idx)
+				}
+				if c.Pos() != 3 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 3, c.Pos())
+				}
+			})
+		})
+	}
+
+	t.Run("Window", func(t *testing.T) {
+		for _, test := range []struct {
+			name string
+			wrap func(Cursor[byte]) Cursor[byte]
+		}{
+			{"Reader", func(c Cursor[byte]) Cursor[byte] { return c }},
+			{"Sync", func(c Cursor[byte]) Cursor[byte] { return Sync(c) }},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				c := test.wrap(ByteReader(strings.NewReader(strings.Repeat("a", 100)+"xyz"), 4))
+				for range 101 {
+					c.Next()
+				}
+				if c.Cur() != 'y' {
+					t.Errorf("unexpected value: wanted %c ; got %c", 'y', c.Cur())
+				}
+				if idx := IndexOf(c, 'y'); idx != 101 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 101, idx)
+				}
+				if first := c.(windowed).first(); IndexOf(c, 'a') != first {
+					t.Errorf("unexpected index: wanted %d ; got %d", first, IndexOf(c, 'a'))
+				}
+				if idx := LastIndexOf(c, 'a'); idx != 99 {
+					t.Errorf("unexpected index: wanted %d ; got %d", 99, idx)
+				}
+				if c.Pos() != 101 {
+					t.Errorf("unexpected position: wanted %d ; got %d", 101, c.Pos())
+				}
+			})
+		}
+	})
+}
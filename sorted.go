@@ -0,0 +1,138 @@
+package cur
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SortedCursor is a Cursor over a sorted slice, which can also seek to items in
+// logarithmic time with a binary search
+type SortedCursor[T any] interface {
+	CheckedCursor[T]
+
+	// SeekGE moves the cursor to the first item greater than or equal to `v`, returning
+	// it and whether it exists
+	//
+	// The cursor is not moved if there is no such item
+	SeekGE(v T) (T, bool)
+
+	// SeekGT moves the cursor to the first item greater than `v`, returning it and
+	// whether it exists
+	//
+	// The cursor is not moved if there is no such item
+	SeekGT(v T) (T, bool)
+
+	// SeekLE moves the cursor to the last item less than or equal to `v`, returning it
+	// and whether it exists
+	//
+	// The cursor is not moved if there is no such item
+	SeekLE(v T) (T, bool)
+
+	// SeekLT moves the cursor to the last item less than `v`, returning it and whether
+	// it exists
+	//
+	// The cursor is not moved if there is no such item
+	SeekLT(v T) (T, bool)
+
+	// EqualRange returns the range of indices [start, end) of the items equal to `v`,
+	// without moving the cursor. If there are none, start and end are both the index
+	// where `v` would be inserted
+	EqualRange(v T) (start, end int)
+}
+
+type sorted[T any] struct {
+	*cursor[T]
+	cmp func(a, b T) int
+}
+
+// Sorted returns a SortedCursor for the input slice, which must be sorted in ascending
+// order, or nil if the slice is empty
+func Sorted[T cmp.Ordered](slice []T, opts ...Option) SortedCursor[T] {
+	return SortedFunc(slice, cmp.Compare[T], opts...)
+}
+
+// SortedFunc returns a SortedCursor for the input slice, which must be sorted in
+// ascending order as defined by the comparison function `cmp`, or nil if the slice is
+// empty or `cmp` is nil
+//
+// `cmp` returns a negative number when a < b, a positive number when a > b and zero
+// when a == b, like the functions in the slices package
+func SortedFunc[T any](slice []T, cmp func(a, b T) int, opts ...Option) SortedCursor[T] {
+	if len(slice) == 0 || cmp == nil {
+		return nil
+	}
+	cfg := newConfig(opts...)
+	return &sorted[T]{
+		cursor: &cursor[T]{
+			slice:  slice,
+			strict: cfg.strict,
+		},
+		cmp: cmp,
+	}
+}
+
+// SeekGE moves the cursor to the first item greater than or equal to `v`, returning
+// it and whether it exists
+//
+// The cursor is not moved if there is no such item
+func (c *sorted[T]) SeekGE(v T) (T, bool) {
+	return c.jump("SeekGE", c.lower(v))
+}
+
+// SeekGT moves the cursor to the first item greater than `v`, returning it and
+// whether it exists
+//
+// The cursor is not moved if there is no such item
+func (c *sorted[T]) SeekGT(v T) (T, bool) {
+	return c.jump("SeekGT", c.upper(v))
+}
+
+// SeekLE moves the cursor to the last item less than or equal to `v`, returning it
+// and whether it exists
+//
+// The cursor is not moved if there is no such item
+func (c *sorted[T]) SeekLE(v T) (T, bool) {
+	return c.jump("SeekLE", c.upper(v)-1)
+}
+
+// SeekLT moves the cursor to the last item less than `v`, returning it and whether
+// it exists
+//
+// The cursor is not moved if there is no such item
+func (c *sorted[T]) SeekLT(v T) (T, bool) {
+	return c.jump("SeekLT", c.lower(v)-1)
+}
+
+// EqualRange returns the range of indices [start, end) of the items equal to `v`,
+// without moving the cursor. If there are none, start and end are both the index
+// where `v` would be inserted
+func (c *sorted[T]) EqualRange(v T) (start, end int) {
+	start = c.lower(v)
+	end = start + sort.Search(len(c.slice)-start, func(i int) bool {
+		return c.cmp(c.slice[start+i], v) > 0
+	})
+	return start, end
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *sorted[T]) Clone() Cursor[T] {
+	return &sorted[T]{
+		cursor: c.cursor.Clone().(*cursor[T]),
+		cmp:    c.cmp,
+	}
+}
+
+// lower returns the index of the first item greater than or equal to `v`
+func (c *sorted[T]) lower(v T) int {
+	return sort.Search(len(c.slice), func(i int) bool {
+		return c.cmp(c.slice[i], v) >= 0
+	})
+}
+
+// upper returns the index of the first item greater than `v`
+func (c *sorted[T]) upper(v T) int {
+	return sort.Search(len(c.slice), func(i int) bool {
+		return c.cmp(c.slice[i], v) > 0
+	})
+}
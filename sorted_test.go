@@ -0,0 +1,115 @@
+package cur
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSorted(t *testing.T) {
+	items := []int{1, 3, 3, 3, 5, 8}
+
+	t.Run("Nil", func(t *testing.T) {
+		if Sorted[int](nil) != nil || SortedFunc(items, nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+
+	for _, test := range []struct {
+		name    string
+		seek    func(SortedCursor[int], int) (int, bool)
+		v       int
+		wants   int
+		wantsOK bool
+	}{
+		{"SeekGE/Equal", SortedCursor[int].SeekGE, 3, 1, true},
+		{"SeekGE/Between", SortedCursor[int].SeekGE, 4, 4, true},
+		{"SeekGE/Below", SortedCursor[int].SeekGE, 0, 0, true},
+		{"SeekGE/Above", SortedCursor[int].SeekGE, 9, 2, false},
+		{"SeekGT/Equal", SortedCursor[int].SeekGT, 3, 4, true},
+		{"SeekGT/Above", SortedCursor[int].SeekGT, 8, 2, false},
+		{"SeekLE/Equal", SortedCursor[int].SeekLE, 3, 3, true},
+		{"SeekLE/Between", SortedCursor[int].SeekLE, 7, 4, true},
+		{"SeekLE/Below", SortedCursor[int].SeekLE, 0, 2, false},
+		{"SeekLT/Equal", SortedCursor[int].SeekLT, 3, 0, true},
+		{"SeekLT/Above", SortedCursor[int].SeekLT, 9, 5, true},
+		{"SeekLT/Below", SortedCursor[int].SeekLT, 1, 2, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := Sorted(items)
+			c.Idx(2)
+			v, ok := test.seek(c, test.v)
+			if ok != test.wantsOK {
+				t.Errorf("unexpected result: wanted %v ; got %v", test.wantsOK, ok)
+			}
+			if c.Pos() != test.wants {
+				t.Errorf("unexpected position: wanted %d ; got %d", test.wants, c.Pos())
+			}
+			if ok && v != items[test.wants] {
+				t.Errorf("unexpected value: wanted %d ; got %d", items[test.wants], v)
+			}
+		})
+	}
+
+	t.Run("EqualRange", func(t *testing.T) {
+		c := Sorted(items)
+		for _, test := range []struct {
+			v          int
+			start, end int
+		}{
+			{3, 1, 4},
+			{8, 5, 6},
+			{4, 4, 4},
+			{0, 0, 0},
+			{9, 6, 6},
+		} {
+			start, end := c.EqualRange(test.v)
+			if start != test.start || end != test.end {
+				t.Errorf("unexpected range for %d: wanted [%d, %d) ; got [%d, %d)", test.v, test.start, test.end, start, end)
+			}
+		}
+		if c.Pos() != 0 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 0, c.Pos())
+		}
+	})
+
+	t.Run("Walk", func(t *testing.T) {
+		c := Sorted(items)
+		c.SeekGE(3)
+		var sum int
+		for _, v := range c.Forward() {
+			sum += v
+		}
+		if sum != 22 {
+			t.Errorf("unexpected value: wanted %d ; got %d", 22, sum)
+		}
+	})
+
+	t.Run("Func", func(t *testing.T) {
+		words := []string{"Apple", "banana", "Cherry"}
+		c := SortedFunc(words, func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		if v, _ := c.SeekGT("BANANA"); v != "Cherry" {
+			t.Errorf("unexpected value: wanted %q ; got %q", "Cherry", v)
+		}
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		c := Sorted(items, WithStrict())
+		c.SeekGT(8)
+		if !errors.Is(Err[int](c), ErrOutOfBounds) {
+			t.Errorf("unexpected error: %v", Err[int](c))
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		c := Sorted(items)
+		c.SeekGE(5)
+		clone := c.Clone().(SortedCursor[int])
+		clone.SeekLT(5)
+		if c.Pos() != 4 || clone.Pos() != 3 {
+			t.Errorf("unexpected positions: wanted %d, %d ; got %d, %d", 4, 3, c.Pos(), clone.Pos())
+		}
+	})
+}
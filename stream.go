@@ -0,0 +1,413 @@
+package cur
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"slices"
+)
+
+// DecodeFunc reads the next item from the buffered reader `r`, returning io.EOF once
+// the input is exhausted
+type DecodeFunc[T any] func(r *bufio.Reader) (T, error)
+
+// Reader returns a Cursor that pulls items from the io.Reader `r` on demand, decoding
+// them with the function `decode`, or nil if either `r` or `decode` are nil
+//
+// Only (at least) the last `lookback` items read are kept in memory, so Prev, Peek,
+// PeekOffset and Extract work within that window behind the furthest read item. Items
+// in or after the position of the cursor reading from the stream are always kept, so
+// peeking or jumping far ahead buffers every item in between. A lookback of zero or
+// less keeps every item read. Len returns the number of items read so far, and the
+// first non-EOF error raised by the reader is returned by Err
+func Reader[T any](r io.Reader, decode DecodeFunc[T], lookback int) Cursor[T] {
+	if r == nil || decode == nil {
+		return nil
+	}
+	br := bufio.NewReader(r)
+	return newStreamCursor(func() (T, error) {
+		return decode(br)
+	}, lookback)
+}
+
+// ByteReader returns a Reader cursor over the bytes in the io.Reader `r`
+func ByteReader(r io.Reader, lookback int) Cursor[byte] {
+	return Reader(r, func(r *bufio.Reader) (byte, error) {
+		return r.ReadByte()
+	}, lookback)
+}
+
+// RuneReader returns a Reader cursor over the UTF-8 encoded runes in the io.Reader `r`
+func RuneReader(r io.Reader, lookback int) Cursor[rune] {
+	return Reader(r, func(r *bufio.Reader) (rune, error) {
+		v, _, err := r.ReadRune()
+		return v, err
+	}, lookback)
+}
+
+// stream buffers the items pulled from a source, shared by the cursors reading from it
+type stream[T any] struct {
+	pull     func() (T, error)
+	stop     func()
+	buf      []T
+	base     int
+	lookback int
+	keep     int
+	done     bool
+	err      error
+}
+
+// len returns the number of items pulled from the source so far
+func (s *stream[T]) len() int {
+	return s.base + len(s.buf)
+}
+
+// fill pulls items from the source until index `idx` is buffered, returning false if
+// the source is exhausted first
+func (s *stream[T]) fill(idx int) bool {
+	for s.len() <= idx {
+		if s.done {
+			return false
+		}
+		v, err := s.pull()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.err = err
+			}
+			s.close()
+			return false
+		}
+		s.buf = append(s.buf, v)
+		s.trim()
+	}
+	return true
+}
+
+// close marks the source as exhausted, releasing it
+func (s *stream[T]) close() {
+	s.done = true
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+}
+
+// trim drops the oldest items from the buffer once it holds twice the lookback window,
+// keeping the items in or after index `keep` unless it is negative
+//
+// Items are only dropped once they make up half of the buffer, so that the cost of
+// moving the remaining ones is amortized
+func (s *stream[T]) trim() {
+	if s.lookback <= 0 {
+		return
+	}
+	drop := len(s.buf) - s.lookback
+	if s.keep >= 0 {
+		drop = min(drop, s.keep-s.base)
+	}
+	if drop <= 0 || drop < len(s.buf)/2 {
+		return
+	}
+	n := copy(s.buf, s.buf[drop:])
+	clear(s.buf[n:])
+	s.buf = s.buf[:n]
+	s.base += drop
+}
+
+// get returns the item in index `idx`, pulling it from the source if needed
+func (s *stream[T]) get(idx int) (T, bool) {
+	if idx < s.base || !s.fill(idx) {
+		var eof T
+		return eof, false
+	}
+	return s.buf[idx-s.base], true
+}
+
+type streamCursor[T any] struct {
+	src *stream[T]
+	pos int
+	tx  txStack
+}
+
+func newStreamCursor[T any](pull func() (T, error), lookback int) *streamCursor[T] {
+	return &streamCursor[T]{
+		src: &stream[T]{
+			pull:     pull,
+			lookback: lookback,
+		},
+	}
+}
+
+// Cur returns the item in the current position
+func (c *streamCursor[T]) Cur() T {
+	v, _ := c.CurOK()
+	return v
+}
+
+// Pos returns the current position in the cursor, or -1 if it is past the end of an
+// exhausted stream or fell out of the lookback window
+//
+// Pos doesn't read from the stream, so it returns the position of an item that wasn't
+// read yet even if the stream turns out to be exhausted before it
+func (c *streamCursor[T]) Pos() int {
+	if c.pos < c.src.base || (c.src.done && c.pos >= c.src.len()) {
+		return -1
+	}
+	return c.pos
+}
+
+// Len returns the number of items read from the stream so far
+func (c *streamCursor[T]) Len() int {
+	return c.src.len()
+}
+
+// Next returns the next item in the stream, or the zero-value for T as EOF
+func (c *streamCursor[T]) Next() T {
+	v, _ := c.NextOK()
+	return v
+}
+
+// Prev returns the previous item in the stream, or the zero-value for T as EOF if
+// index is / would be less than zero or outside of the lookback window
+func (c *streamCursor[T]) Prev() T {
+	v, _ := c.PrevOK()
+	return v
+}
+
+// Peek returns the next indexed item without advancing the cursor
+//
+// If the next token overflows the stream, returns the zero-value for T as EOF
+func (c *streamCursor[T]) Peek() T {
+	v, _ := c.PeekOK()
+	return v
+}
+
+// Head returns to the oldest item kept in the lookback window
+func (c *streamCursor[T]) Head() T {
+	c.pos = c.src.base
+	return c.Next()
+}
+
+// Tail reads the stream until it is exhausted, jumping to its last item
+func (c *streamCursor[T]) Tail() T {
+	c.src.keep = -1
+	for ok := true; ok; {
+		ok = c.src.fill(c.src.len())
+	}
+	c.pos = c.src.len()
+	return c.Prev()
+}
+
+// Idx jumps to the specific index `idx` in the stream, reading it if needed
+//
+// If the input index is outside of the lookback window, the zero-value for T as EOF
+// If the input index is greater than the size of the stream, the zero-value for T as EOF
+func (c *streamCursor[T]) Idx(idx int) T {
+	v, _ := c.IdxOK(idx)
+	return v
+}
+
+// Offset advances or rewinds `amount` steps in the stream, be it a positive or negative
+// input.
+//
+// If the result offset is outside of the lookback window, the zero-value for T as EOF
+// If the result offset is greater than the size of the stream, the zero-value for T as EOF
+func (c *streamCursor[T]) Offset(amount int) T {
+	v, _ := c.OffsetOK(amount)
+	return v
+}
+
+// PeekIdx returns the item in index `idx` without moving the cursor
+//
+// If the input index is outside of the lookback window, the zero-value for T as EOF
+// If the input index is greater than the size of the stream, the zero-value for T as EOF
+func (c *streamCursor[T]) PeekIdx(idx int) T {
+	v, _ := c.PeekIdxOK(idx)
+	return v
+}
+
+// PeekOffset returns the item `amount` steps away without moving the cursor
+//
+// If the result offset is outside of the lookback window, the zero-value for T as EOF
+// If the result offset is greater than the size of the stream, the zero-value for T as EOF
+func (c *streamCursor[T]) PeekOffset(amount int) T {
+	v, _ := c.PeekOffsetOK(amount)
+	return v
+}
+
+// Extract returns a copy of the items from index `start` to index `end`, limited to the
+// ones already read and kept in the lookback window
+func (c *streamCursor[T]) Extract(start, end int) []T {
+	start = max(start, c.src.base)
+	end = min(end, c.src.len())
+	if start >= end {
+		return nil
+	}
+
+	return slices.Clone(c.src.buf[start-c.src.base : end-c.src.base])
+}
+
+// Err returns the first error raised when reading from the stream, other than io.EOF
+func (c *streamCursor[T]) Err() error {
+	return c.src.err
+}
+
+// mutatesOnRead returns true, as the read-only methods may pull items from the stream
+func (c *streamCursor[T]) mutatesOnRead() bool {
+	return true
+}
+
+// first returns the index of the oldest item kept in the lookback window
+func (c *streamCursor[T]) first() int {
+	return c.src.base
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+//
+// The clone shares the stream and its lookback window with this cursor
+func (c *streamCursor[T]) Clone() Cursor[T] {
+	return &streamCursor[T]{
+		src: c.src,
+		pos: c.pos,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (c *streamCursor[T]) Mark() Mark {
+	return Mark(c.pos)
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (c *streamCursor[T]) Reset(m Mark) {
+	c.pos = clamp(int(m), -1)
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (c *streamCursor[T]) Begin() {
+	c.tx.push(c.Mark())
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *streamCursor[T]) Commit() error {
+	_, err := c.tx.pop()
+	return err
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (c *streamCursor[T]) Rollback() error {
+	m, err := c.tx.pop()
+	if err != nil {
+		return err
+	}
+	c.Reset(m)
+	return nil
+}
+
+// All returns an iterator over the index and item pairs in the stream, moving the
+// cursor to the head of the lookback window and walking forward until the end of
+// the stream
+//
+// The cursor is left on the item where the iteration stopped
+func (c *streamCursor[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.pos = c.src.base
+		forward[T](c)(yield)
+	}
+}
+
+// Forward returns an iterator over the index and item pairs in the stream, walking
+// forward from the cursor's current position until the end of the stream
+//
+// The cursor is left on the item where the iteration stopped
+func (c *streamCursor[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](c)
+}
+
+// Backward returns an iterator over the index and item pairs in the stream, walking
+// backwards from the cursor's current position until the head of the lookback window
+//
+// The cursor is left on the item where the iteration stopped
+func (c *streamCursor[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](c)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the stream
+func (c *streamCursor[T]) CurOK() (T, bool) {
+	return c.get(c.pos)
+}
+
+// NextOK advances the cursor, returning the next item in the stream and whether
+// it was within the bounds of the stream
+func (c *streamCursor[T]) NextOK() (T, bool) {
+	v, ok := c.get(c.pos)
+	if ok {
+		c.pos++
+	}
+	return v, ok
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the stream and whether
+// it was within the lookback window
+func (c *streamCursor[T]) PrevOK() (T, bool) {
+	v, ok := c.get(c.pos - 1)
+	if ok {
+		c.pos--
+	}
+	return v, ok
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the stream
+func (c *streamCursor[T]) PeekOK() (T, bool) {
+	return c.get(c.pos + 1)
+}
+
+// IdxOK jumps to the specific index `idx` in the stream, returning the item and
+// whether `idx` was within the bounds of the stream and the lookback window
+//
+// The cursor is not moved if `idx` is out of bounds
+func (c *streamCursor[T]) IdxOK(idx int) (T, bool) {
+	v, ok := c.get(idx)
+	if ok {
+		c.pos = idx
+	}
+	return v, ok
+}
+
+// OffsetOK advances or rewinds `amount` steps in the stream, returning the item and
+// whether the result offset was within the bounds of the stream and the lookback window
+//
+// The cursor is not moved if the result offset is out of bounds
+func (c *streamCursor[T]) OffsetOK(amount int) (T, bool) {
+	return c.IdxOK(c.pos + amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the stream and the lookback window
+func (c *streamCursor[T]) PeekIdxOK(idx int) (T, bool) {
+	return c.get(idx)
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the stream and the lookback
+// window
+func (c *streamCursor[T]) PeekOffsetOK(amount int) (T, bool) {
+	return c.get(c.pos + amount)
+}
+
+// get returns the item in index `idx` from the stream, keeping the item in the cursor's
+// position if items need to be pulled
+func (c *streamCursor[T]) get(idx int) (T, bool) {
+	c.src.keep = c.pos
+	return c.src.get(idx)
+}
@@ -0,0 +1,151 @@
+package cur
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReader(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		if Reader[byte](nil, nil, 0) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("Next", func(t *testing.T) {
+		c := ByteReader(strings.NewReader("abcdef"), 2)
+		var got []byte
+		for v, ok := Check(c).NextOK(); ok; v, ok = Check(c).NextOK() {
+			got = append(got, v)
+		}
+		if string(got) != "abcdef" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "abcdef", got)
+		}
+		if c.Len() != 6 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 6, c.Len())
+		}
+		if c.Pos() != -1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+		}
+	})
+	t.Run("Lookback", func(t *testing.T) {
+		c := ByteReader(strings.NewReader("abcdefghij"), 3)
+		c.Idx(7)
+		if v := c.Cur(); v != 'h' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'h', v)
+		}
+		if c.Len() != 8 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 8, c.Len())
+		}
+		if v := c.Peek(); v != 'i' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'i', v)
+		}
+		if v := c.PeekOffset(-1); v != 'g' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'g', v)
+		}
+		if v := c.PeekOffset(-2); v != 0 {
+			t.Errorf("unexpected value: wanted %q ; got %q", 0, v)
+		}
+		if v := c.Prev(); v != 'g' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'g', v)
+		}
+		if got := string(c.Extract(6, 9)); got != "ghi" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "ghi", got)
+		}
+		if v := c.Idx(0); v != 0 {
+			t.Errorf("unexpected value: wanted %q ; got %q", 0, v)
+		}
+		if c.Pos() != 6 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 6, c.Pos())
+		}
+	})
+	t.Run("Tail", func(t *testing.T) {
+		c := RuneReader(strings.NewReader("héllo, 世界"), 4)
+		if v := c.Tail(); v != '界' {
+			t.Errorf("unexpected value: wanted %q ; got %q", '界', v)
+		}
+		if v := c.Prev(); v != '世' {
+			t.Errorf("unexpected value: wanted %q ; got %q", '世', v)
+		}
+		if c.Len() != 9 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 9, c.Len())
+		}
+	})
+	t.Run("Trimmed", func(t *testing.T) {
+		c := ByteReader(strings.NewReader(strings.Repeat("abcdefghij", 10)), 2)
+		c.Idx(50)
+		c.Offset(10)
+		if v := c.Extract(0, 1); v != nil {
+			t.Errorf("unexpected output: wanted %v ; got %q", nil, v)
+		}
+		if v := c.Extract(55, 45); v != nil {
+			t.Errorf("unexpected output: wanted %v ; got %q", nil, v)
+		}
+		if got := string(c.Extract(0, 61)); got != "abcdefghija" {
+			t.Errorf("unexpected output: wanted %q ; got %q", "abcdefghija", got)
+		}
+	})
+	t.Run("FarPeek", func(t *testing.T) {
+		c := ByteReader(strings.NewReader(strings.Repeat("abcdefghij", 10)), 2)
+		c.Next()
+		if v := c.PeekOffset(20); v != 'b' {
+			t.Errorf("unexpected value: wanted %q ; got %q", 'b', v)
+		}
+		if c.Pos() != 1 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 1, c.Pos())
+		}
+		for _, want := range []byte("bcd") {
+			if v := c.Next(); v != want {
+				t.Errorf("unexpected value: wanted %q ; got %q", want, v)
+			}
+		}
+		for range c.Forward() {
+		}
+		if c.Len() != 100 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 100, c.Len())
+		}
+	})
+	t.Run("Pos", func(t *testing.T) {
+		var reads int
+		c := Reader(strings.NewReader("ab"), func(r *bufio.Reader) (byte, error) {
+			reads++
+			return r.ReadByte()
+		}, 0)
+		if c.Pos() != 0 || reads != 0 {
+			t.Errorf("unexpected reads: wanted %d ; got %d", 0, reads)
+		}
+		c.Next()
+		c.Next()
+		if c.Pos() != 2 || reads != 2 {
+			t.Errorf("unexpected reads: wanted %d ; got %d", 2, reads)
+		}
+		c.Next()
+		if c.Pos() != -1 || reads != 3 {
+			t.Errorf("unexpected position: wanted %d ; got %d", -1, c.Pos())
+		}
+	})
+	t.Run("Decode", func(t *testing.T) {
+		lines := Reader(strings.NewReader("a\nb\nc\n"), func(r *bufio.Reader) (string, error) {
+			line, err := r.ReadString('\n')
+			return strings.TrimSuffix(line, "\n"), err
+		}, 0)
+
+		var got []string
+		for _, line := range lines.All() {
+			got = append(got, line)
+		}
+		if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+			t.Errorf("unexpected output: wanted %v ; got %v", want, got)
+		}
+	})
+	t.Run("Err", func(t *testing.T) {
+		c := ByteReader(iotest.TimeoutReader(strings.NewReader("ab")), 0)
+		c.Tail()
+		if err := Err(c); !errors.Is(err, iotest.ErrTimeout) {
+			t.Errorf("unexpected error: wanted %v ; got %v", iotest.ErrTimeout, err)
+		}
+	})
+}
@@ -0,0 +1,365 @@
+package cur
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncCursor is a Cursor that is safe for concurrent use by multiple goroutines
+type SyncCursor[T any] interface {
+	CheckedCursor[T]
+
+	// NextIf advances the cursor only if the current item matches `pred`, as one atomic
+	// operation. It returns the current item and whether the cursor was advanced
+	NextIf(pred func(T) bool) (T, bool)
+
+	// Do calls `fn` with the wrapped Cursor while holding the lock, to run compound operations
+	// atomically, or to modify the data backing the cursor, such as the slice in a Ptr cursor
+	//
+	// `fn` must not call any methods on the SyncCursor itself
+	Do(fn func(c Cursor[T]))
+}
+
+type syncCursor[T any] struct {
+	mu     *sync.RWMutex
+	c      CheckedCursor[T]
+	shared bool
+}
+
+// Sync returns a SyncCursor wrapping `c`, which serializes access to it with a mutex, or nil
+// if `c` is nil
+//
+// Cur, Pos, Len, Peek and the remaining methods that don't move the cursor only take a read
+// lock, unless they modify the state of `c`, like in strict cursors or in cursors that
+// materialize items as they are read (Reader, Lazy and Paged)
+func Sync[T any](c Cursor[T]) SyncCursor[T] {
+	if c == nil {
+		return nil
+	}
+	if s, ok := c.(*syncCursor[T]); ok {
+		return s
+	}
+
+	shared := true
+	if m, ok := c.(readMutator); ok {
+		shared = !m.mutatesOnRead()
+	}
+	return &syncCursor[T]{
+		mu:     &sync.RWMutex{},
+		c:      Check(c),
+		shared: shared,
+	}
+}
+
+// readMutator is implemented by cursors whose read-only methods may still modify their state
+type readMutator interface {
+	mutatesOnRead() bool
+}
+
+// wrapper is implemented by cursors whose navigation wraps around the slice instead of
+// reaching its end
+type wrapper interface {
+	wraps() bool
+}
+
+// wraps returns whether the wrapped cursor wraps around the slice
+func (s *syncCursor[T]) wraps() bool {
+	w, ok := s.c.(wrapper)
+	return ok && w.wraps()
+}
+
+// windowed is implemented by cursors that drop their oldest items, returning the index
+// of the oldest item still kept
+type windowed interface {
+	first() int
+}
+
+// first returns the index of the oldest item kept by the wrapped cursor
+func (s *syncCursor[T]) first() int {
+	w, ok := s.c.(windowed)
+	if !ok {
+		return 0
+	}
+	defer s.rlock()()
+	return w.first()
+}
+
+// rlock takes a read lock if the wrapped cursor supports concurrent reads, or a write lock
+// otherwise, returning the function that releases it
+func (s *syncCursor[T]) rlock() func() {
+	if s.shared {
+		s.mu.RLock()
+		return s.mu.RUnlock
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// lock takes a write lock, returning the function that releases it
+func (s *syncCursor[T]) lock() func() {
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// NextIf advances the cursor only if the current item matches `pred`, as one atomic
+// operation. It returns the current item and whether the cursor was advanced
+func (s *syncCursor[T]) NextIf(pred func(T) bool) (T, bool) {
+	defer s.lock()()
+	v, ok := s.c.CurOK()
+	if !ok || !pred(v) {
+		return v, false
+	}
+	s.c.Next()
+	return v, true
+}
+
+// Do calls `fn` with the wrapped Cursor while holding the lock, to run compound operations
+// atomically, or to modify the data backing the cursor, such as the slice in a Ptr cursor
+//
+// `fn` must not call any methods on the SyncCursor itself
+func (s *syncCursor[T]) Do(fn func(c Cursor[T])) {
+	defer s.lock()()
+	fn(s.c)
+}
+
+// Err returns the error raised by the last navigation call in the wrapped Cursor
+func (s *syncCursor[T]) Err() error {
+	defer s.rlock()()
+	return Err[T](s.c)
+}
+
+// Cur returns the item in the current position
+func (s *syncCursor[T]) Cur() T {
+	defer s.rlock()()
+	return s.c.Cur()
+}
+
+// Pos returns the current position in the cursor
+func (s *syncCursor[T]) Pos() int {
+	defer s.rlock()()
+	return s.c.Pos()
+}
+
+// Len returns the total size of the underlying slice
+func (s *syncCursor[T]) Len() int {
+	defer s.rlock()()
+	return s.c.Len()
+}
+
+// Next returns the next item in the slice, or the zero-value for T as EOF
+func (s *syncCursor[T]) Next() T {
+	defer s.lock()()
+	return s.c.Next()
+}
+
+// Prev returns the previous item in the slice, or the zero-value for T as EOF if
+// index is / would be less than zero
+func (s *syncCursor[T]) Prev() T {
+	defer s.lock()()
+	return s.c.Prev()
+}
+
+// Peek returns the next indexed item without advancing the cursor
+//
+// If the next token overflows the slice, returns the zero-value for T as EOF
+func (s *syncCursor[T]) Peek() T {
+	defer s.rlock()()
+	return s.c.Peek()
+}
+
+// Head returns to the beginning of the slice
+func (s *syncCursor[T]) Head() T {
+	defer s.lock()()
+	return s.c.Head()
+}
+
+// Tail jumps to the end of the slice
+func (s *syncCursor[T]) Tail() T {
+	defer s.lock()()
+	return s.c.Tail()
+}
+
+// Idx jumps to the specific index `idx` in the slice
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the slice, the zero-value for T as EOF
+func (s *syncCursor[T]) Idx(idx int) T {
+	defer s.lock()()
+	return s.c.Idx(idx)
+}
+
+// Offset advances or rewinds `amount` steps in the slice, be it a positive or negative
+// input.
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the size of the slice, the zero-value for T as EOF
+func (s *syncCursor[T]) Offset(amount int) T {
+	defer s.lock()()
+	return s.c.Offset(amount)
+}
+
+// PeekIdx returns the next indexed item without advancing the cursor,
+// with the index `idx`
+//
+// If the input index is below 0, the zero-value for T as EOF
+// If the input index is greater than the size of the slice, the zero-value for T as EOF
+func (s *syncCursor[T]) PeekIdx(idx int) T {
+	defer s.rlock()()
+	return s.c.PeekIdx(idx)
+}
+
+// PeekOffset returns the next indexed item without advancing the cursor,
+// with offset `amount`
+//
+// If the result offset is below 0, the zero-value for T as EOF
+// If the result offset is greater than the size of the slice, the zero-value for T as EOF
+func (s *syncCursor[T]) PeekOffset(amount int) T {
+	defer s.rlock()()
+	return s.c.PeekOffset(amount)
+}
+
+// Extract returns a slice from index `start` to index `end`
+//
+// The returned slice is not protected by the lock
+func (s *syncCursor[T]) Extract(start, end int) []T {
+	// an Editor rearranges its buffer to extract items
+	defer s.lock()()
+	return s.c.Extract(start, end)
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+//
+// The clone shares the lock with this cursor, and wraps a clone of its Cursor
+func (s *syncCursor[T]) Clone() Cursor[T] {
+	defer s.lock()()
+	return &syncCursor[T]{
+		mu:     s.mu,
+		c:      Check(s.c.Clone()),
+		shared: s.shared,
+	}
+}
+
+// Mark returns a snapshot of the cursor's current position, which can be restored
+// with Reset
+func (s *syncCursor[T]) Mark() Mark {
+	defer s.rlock()()
+	return s.c.Mark()
+}
+
+// Reset returns the cursor to the position captured in the Mark `m`
+func (s *syncCursor[T]) Reset(m Mark) {
+	defer s.lock()()
+	s.c.Reset(m)
+}
+
+// Begin opens a transaction, saving the cursor's current position. Transactions
+// can be nested, each call to Begin requiring its own Commit or Rollback
+func (s *syncCursor[T]) Begin() {
+	defer s.lock()()
+	s.c.Begin()
+}
+
+// Commit closes the innermost transaction, keeping the cursor's current position
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (s *syncCursor[T]) Commit() error {
+	defer s.lock()()
+	return s.c.Commit()
+}
+
+// Rollback closes the innermost transaction, returning the cursor to the position
+// it was in when the transaction was opened
+//
+// Returns ErrNoTransaction if there are no open transactions
+func (s *syncCursor[T]) Rollback() error {
+	defer s.lock()()
+	return s.c.Rollback()
+}
+
+// All returns an iterator over the index and item pairs in the slice, moving the
+// cursor to its head and walking forward until the end of the slice, or for one lap on
+// cursors that wrap around, like Ring
+//
+// The lock is only held while moving the cursor, not during the whole iteration
+func (s *syncCursor[T]) All() iter.Seq2[int, T] {
+	return all[T](s)
+}
+
+// Forward returns an iterator over the index and item pairs in the slice, walking
+// forward from the cursor's current position until the end of the slice, or for one lap
+// on cursors that wrap around, like Ring
+//
+// The lock is only held while moving the cursor, not during the whole iteration
+func (s *syncCursor[T]) Forward() iter.Seq2[int, T] {
+	return forward[T](s)
+}
+
+// Backward returns an iterator over the index and item pairs in the slice, walking
+// backwards from the cursor's current position until the head of the slice, or for one
+// lap on cursors that wrap around, like Ring
+//
+// The lock is only held while moving the cursor, not during the whole iteration
+func (s *syncCursor[T]) Backward() iter.Seq2[int, T] {
+	return backward[T](s)
+}
+
+// CurOK returns the item in the current position, and whether the cursor
+// is within the bounds of the slice
+func (s *syncCursor[T]) CurOK() (T, bool) {
+	defer s.rlock()()
+	return s.c.CurOK()
+}
+
+// NextOK advances the cursor, returning the next item in the slice and whether
+// it was within the bounds of the slice
+func (s *syncCursor[T]) NextOK() (T, bool) {
+	defer s.lock()()
+	return s.c.NextOK()
+}
+
+// PrevOK rewinds the cursor, returning the previous item in the slice and whether
+// it was within the bounds of the slice
+func (s *syncCursor[T]) PrevOK() (T, bool) {
+	defer s.lock()()
+	return s.c.PrevOK()
+}
+
+// PeekOK returns the next indexed item without advancing the cursor, and whether
+// it was within the bounds of the slice
+func (s *syncCursor[T]) PeekOK() (T, bool) {
+	defer s.rlock()()
+	return s.c.PeekOK()
+}
+
+// IdxOK jumps to the specific index `idx` in the slice, returning the item and
+// whether `idx` was within the bounds of the slice
+//
+// The cursor is not moved if `idx` is out of bounds
+func (s *syncCursor[T]) IdxOK(idx int) (T, bool) {
+	defer s.lock()()
+	return s.c.IdxOK(idx)
+}
+
+// OffsetOK advances or rewinds `amount` steps in the slice, returning the item and
+// whether the result offset was within the bounds of the slice
+//
+// The cursor is not moved if the result offset is out of bounds
+func (s *syncCursor[T]) OffsetOK(amount int) (T, bool) {
+	defer s.lock()()
+	return s.c.OffsetOK(amount)
+}
+
+// PeekIdxOK returns the item in index `idx` without moving the cursor, and whether
+// `idx` was within the bounds of the slice
+func (s *syncCursor[T]) PeekIdxOK(idx int) (T, bool) {
+	defer s.rlock()()
+	return s.c.PeekIdxOK(idx)
+}
+
+// PeekOffsetOK returns the item `amount` steps away from the cursor without moving it,
+// and whether the result offset was within the bounds of the slice
+func (s *syncCursor[T]) PeekOffsetOK(amount int) (T, bool) {
+	defer s.rlock()()
+	return s.c.PeekOffsetOK(amount)
+}
@@ -0,0 +1,98 @@
+package cur
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSync(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		if Sync[int](nil) != nil {
+			t.Errorf("expected cursor to be nil")
+		}
+	})
+	t.Run("NextIf", func(t *testing.T) {
+		c := Sync(New([]int{2, 4, 5}))
+		if v, ok := c.NextIf(func(v int) bool { return v%2 == 0 }); !ok || v != 2 {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", 2, v, ok)
+		}
+		c.Next()
+		if v, ok := c.NextIf(func(v int) bool { return v%2 == 0 }); ok || v != 5 {
+			t.Errorf("unexpected value: wanted %d ; got %d (%v)", 5, v, ok)
+		}
+		if c.Pos() != 2 {
+			t.Errorf("unexpected position: wanted %d ; got %d", 2, c.Pos())
+		}
+	})
+	t.Run("Concurrent", func(t *testing.T) {
+		data := make([]int, 1000)
+		for i := range data {
+			data[i] = i
+		}
+
+		for _, test := range []struct {
+			name string
+			c    Cursor[int]
+		}{
+			{"New", New(data)},
+			{"Strict", New(data, WithStrict())},
+			{"Lazy", LazySeq(slices.Values(data))},
+			{"Filter", Filter(New(data), func(int) bool { return true })},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				c := Sync(test.c)
+				results := make([][]int, 8)
+
+				var wg sync.WaitGroup
+				for i := range results {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for {
+							c.Peek()
+							c.Cur()
+							v, ok := c.NextOK()
+							if !ok {
+								return
+							}
+							results[i] = append(results[i], v)
+						}
+					}()
+				}
+				wg.Wait()
+
+				got := slices.Concat(results...)
+				slices.Sort(got)
+				if !slices.Equal(got, data) {
+					t.Errorf("expected every item to be consumed exactly once")
+				}
+			})
+		}
+	})
+	t.Run("Do", func(t *testing.T) {
+		data := []int{1}
+		c := Sync(Ptr(&data))
+
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				c.Do(func(Cursor[int]) {
+					data = append(data, i)
+				})
+			}()
+			go func() {
+				defer wg.Done()
+				c.Len()
+				c.Tail()
+			}()
+		}
+		wg.Wait()
+
+		if c.Len() != 11 {
+			t.Errorf("unexpected length: wanted %d ; got %d", 11, c.Len())
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package cur
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// walkLimit is the maximum distance in bytes that a TextCursor walks from its last known
+// Position, before looking up the line table instead
+const walkLimit = 256
+
+// Position describes a location in a text input
+type Position struct {
+	// Offset is the byte offset in the input, starting at 0
+	Offset int
+	// Line is the line number, starting at 1
+	Line int
+	// Column is the rune column in the line, starting at 1
+	Column int
+}
+
+// String returns the Position formatted as "line:column"
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// TextCursor is a RuneCursor that also keeps track of the line and column of
+// its current rune
+type TextCursor interface {
+	RuneCursor
+
+	// Position returns the Position of the current rune, or of the end of the input when
+	// the cursor is past its last rune
+	Position() Position
+
+	// PositionAt returns the Position of the byte offset `offset` in the input
+	PositionAt(offset int) Position
+}
+
+type textCursor struct {
+	*runeCursor
+
+	at    Position
+	lines []int
+}
+
+// Text returns a TextCursor for the input string, or nil if the string is empty
+func Text(s string) TextCursor {
+	if len(s) == 0 {
+		return nil
+	}
+	return newTextCursor(newRuneCursor(s, nil))
+}
+
+// TextBytes returns a TextCursor for the input byte slice, or nil if the slice is empty
+func TextBytes(b []byte) TextCursor {
+	if len(b) == 0 {
+		return nil
+	}
+	return newTextCursor(newRuneCursor("", b))
+}
+
+func newTextCursor(c *runeCursor) *textCursor {
+	return &textCursor{
+		runeCursor: c,
+		at:         Position{Line: 1, Column: 1},
+	}
+}
+
+// Clone returns a new, independent cursor in the same position and over the same
+// data as this one. Open transactions are not carried over to the clone
+func (c *textCursor) Clone() Cursor[rune] {
+	return &textCursor{
+		runeCursor: c.runeCursor.clone(),
+		at:         c.at,
+		lines:      c.lines,
+	}
+}
+
+// Position returns the Position of the current rune, or of the end of the input when
+// the cursor is past its last rune
+//
+// The Position is updated incrementally from the last one returned, as long as the cursor
+// didn't move too far away from it; otherwise it is looked up in the line table
+func (c *textCursor) Position() Position {
+	c.at = c.walk(c.at, c.off)
+	return c.at
+}
+
+// PositionAt returns the Position of the byte offset `offset` in the input
+func (c *textCursor) PositionAt(offset int) Position {
+	offset = clamp(offset, c.size)
+	if c.lines == nil {
+		c.lines = c.lineStarts()
+	}
+
+	line := sort.Search(len(c.lines), func(i int) bool {
+		return c.lines[i] > offset
+	}) - 1
+
+	return Position{
+		Offset: offset,
+		Line:   line + 1,
+		Column: utf8.RuneCountInString(c.substr(c.lines[line], offset)) + 1,
+	}
+}
+
+// walk returns the Position for the byte offset `offset` by walking from the Position
+// `from`, falling back to PositionAt if it is too far away or if it crosses a line
+// backwards
+func (c *textCursor) walk(from Position, offset int) Position {
+	switch d := offset - from.Offset; {
+	case d >= 0 && d <= walkLimit:
+		for from.Offset < offset {
+			r, w := c.decode(from.Offset)
+			from.Offset += w
+			if r == '\n' {
+				from.Line++
+				from.Column = 1
+				continue
+			}
+			from.Column++
+		}
+		return from
+	case d < 0 && -d <= walkLimit:
+		for from.Offset > offset {
+			r, w := c.decodeLast(from.Offset)
+			if r == '\n' {
+				return c.PositionAt(offset)
+			}
+			from.Offset -= w
+			from.Column--
+		}
+		return from
+	default:
+		return c.PositionAt(offset)
+	}
+}
+
+// lineStarts returns the byte offsets where each line in the input starts
+func (c *textCursor) lineStarts() []int {
+	lines := []int{0}
+	for off := 0; off < c.size; {
+		var i int
+		if c.bytes != nil {
+			i = bytes.IndexByte(c.bytes[off:], '\n')
+		} else {
+			i = strings.IndexByte(c.str[off:], '\n')
+		}
+		if i < 0 {
+			break
+		}
+		off += i + 1
+		lines = append(lines, off)
+	}
+	return lines
+}
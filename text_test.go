@@ -0,0 +1,70 @@
+package cur
+
+import "testing"
+
+func TestText(t *testing.T) {
+	const input = "let x = 1;\nlet ü = \"ñ\";\n\nx + ü"
+
+	for _, test := range []struct {
+		name string
+		c    func() TextCursor
+	}{
+		{"String", func() TextCursor { return Text(input) }},
+		{"Bytes", func() TextCursor { return TextBytes([]byte(input)) }},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("Next", func(t *testing.T) {
+				c := test.c()
+				line, col := 1, 1
+				for r, ok := c.CurOK(); ok; r, ok = c.CurOK() {
+					p := c.Position()
+					if p.Line != line || p.Column != col {
+						t.Fatalf("unexpected position for %q: wanted %d:%d ; got %s", r, line, col, p)
+					}
+					if p.Offset != c.ByteOffset() {
+						t.Fatalf("unexpected offset: wanted %d ; got %d", c.ByteOffset(), p.Offset)
+					}
+					if r == '\n' {
+						line++
+						col = 0
+					}
+					col++
+					c.Next()
+				}
+				if p := c.Position(); p.Line != 4 || p.Column != 6 {
+					t.Errorf("unexpected position: wanted %s ; got %s", "4:6", p)
+				}
+			})
+			t.Run("Prev", func(t *testing.T) {
+				c := test.c()
+				c.Idx(13)
+				if p := c.Position(); p.String() != "2:3" {
+					t.Errorf("unexpected position: wanted %s ; got %s", "2:3", p)
+				}
+				for _, want := range []string{"2:2", "2:1", "1:11", "1:10"} {
+					c.Prev()
+					if p := c.Position(); p.String() != want {
+						t.Errorf("unexpected position: wanted %s ; got %s", want, p)
+					}
+				}
+			})
+			t.Run("Idx", func(t *testing.T) {
+				c := test.c()
+				for idx, want := range map[int]string{
+					25: "4:1", 0: "1:1", 17: "2:7", 24: "3:1", 29: "4:5", 11: "2:1",
+				} {
+					c.Idx(idx)
+					if p := c.Position(); p.String() != want {
+						t.Errorf("unexpected position for index %d: wanted %s ; got %s", idx, want, p)
+					}
+				}
+			})
+			t.Run("PositionAt", func(t *testing.T) {
+				c := test.c()
+				if p := c.PositionAt(len("let x = 1;\nlet ü")); p.String() != "2:6" {
+					t.Errorf("unexpected position: wanted %s ; got %s", "2:6", p)
+				}
+			})
+		})
+	}
+}